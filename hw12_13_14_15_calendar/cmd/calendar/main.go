@@ -3,11 +3,13 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 	"path/filepath"
 
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/app"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/auth"
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/logger"
 	internalgrpc "github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server/grpc"
 	internalhttp "github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server/http"
@@ -17,11 +19,22 @@ import (
 func main() {
 	conf := NewConfig().CalendarConf
 	storage := storage.NewStorage(conf.Storage)
+	if flag.Arg(0) == "migrate" {
+		os.Exit(migrate(storage, flag.Arg(1)))
+	}
 	logger := logger.NewLogger(conf.Logger.Level, os.Stdout)
 	calendar := app.NewCalendar(logger, conf, storage)
 	httpsrv := internalhttp.NewServer(logger, calendar, conf.HTTP.Host, conf.HTTP.Port)
 	grpcsrv, _ := internalgrpc.NewServer(logger, calendar, conf.GRPC.Host, conf.GRPC.Port)
 
+	authenticator, err := auth.New(conf.Auth)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Can't configure authentication: %v\n", err)
+		os.Exit(1)
+	}
+	httpsrv.SetAuthenticator(authenticator)
+	grpcsrv.SetAuthenticator(authenticator)
+
 	calendar.Run(httpsrv, grpcsrv)
 
 	filename := filepath.Base(os.Args[0])
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/storage"
+)
+
+// migrate runs "calendar migrate up|down|status" on the configured storage and returns the exit code.
+func migrate(st storage.Storage, command string) int {
+	m, ok := st.(storage.Migrator)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Storage has no schema to migrate")
+		return 1
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if err := st.Connect(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Can't connect to storage:%v\n", err)
+		return 1
+	}
+	defer st.Close(ctx)
+
+	if err := m.Migrate(ctx, command, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "Can't migrate storage:%v\n", err)
+		return 1
+	}
+	return 0
+}
@@ -8,14 +8,14 @@ import (
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/app"
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/logger"
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/storage"
-	internalrmq "github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/transport/rabbitmq"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/transport"
 )
 
 func main() {
 	conf := NewConfig().SchedulerConf
 	storage := storage.NewStorage(conf.Storage)
 	logger := logger.NewLogger(conf.Logger.Level, os.Stdout)
-	producer := internalrmq.NewProducer(logger, conf.URLRMQ)
+	producer := transport.NewPublisher(logger, conf.Broker, conf.URLRMQ)
 	scheduler := app.NewScheduler(logger, conf, storage, producer)
 
 	scheduler.Run()
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/app"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/logger"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/transport"
+)
+
+// deadLetters runs "sender deadletters list" and "sender deadletters requeue [limit]" on the dead-lettered
+// notifications and returns the exit code.
+func deadLetters(conf app.SenderConf, command, arg string) int {
+	limit := 0
+	if arg != "" {
+		var err error
+		if limit, err = strconv.Atoi(arg); err != nil || limit <= 0 {
+			fmt.Fprintf(os.Stderr, "Wrong limit %q\n", arg)
+			return 1
+		}
+	}
+	if command != "list" && command != "requeue" {
+		fmt.Fprintln(os.Stderr, "Usage: sender deadletters list|requeue [limit]")
+		return 1
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	log := logger.NewLogger(conf.Logger.Level, os.Stderr)
+	dead := transport.NewDeadLetters(log, conf.Broker, conf.URLRMQ, conf.Queue)
+	if err := dead.Connect(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Can't connect to broker:%v\n", err)
+		return 1
+	}
+	defer dead.Close(ctx)
+
+	if command == "requeue" {
+		moved, err := dead.Requeue(ctx, limit)
+		fmt.Printf("Requeued notifications:%v\n", moved)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Can't requeue notifications:%v\n", err)
+			return 1
+		}
+		return 0
+	}
+
+	letters, err := dead.List(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Can't list notifications:%v\n", err)
+		return 1
+	}
+	for i, letter := range letters {
+		if limit > 0 && i == limit {
+			break
+		}
+		fmt.Printf("%d\tevent:%v user:%v title:%q date:%v retries:%v failed:%v error:%v\n", i+1,
+			letter.Msg.ID, letter.Msg.UserID, letter.Msg.Title, letter.Msg.Date.Format(time.RFC3339),
+			letter.Retries, letter.Timestamp.Format(time.RFC3339), letter.Error)
+	}
+	fmt.Printf("Dead-lettered notifications:%v\n", len(letters))
+	return 0
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -8,14 +9,20 @@ import (
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/app"
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/logger"
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/storage"
-	internalrmq "github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/transport/rabbitmq"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/transport"
 )
 
 func main() {
 	conf := NewConfig().SenderConf
+	if flag.Arg(0) == "preview" {
+		os.Exit(preview(conf.Notify, flag.Arg(1), flag.Arg(2), flag.Arg(3)))
+	}
+	if flag.Arg(0) == "deadletters" {
+		os.Exit(deadLetters(conf, flag.Arg(1), flag.Arg(2)))
+	}
 	storage := storage.NewStorage(conf.Storage)
 	logger := logger.NewLogger(conf.Logger.Level, os.Stdout)
-	consumer := internalrmq.NewConsumer(logger, conf.URLRMQ)
+	consumer := transport.NewSubscriber(logger, conf.Broker, conf.URLRMQ, conf.Queue)
 	sender := app.NewSender(logger, conf, storage, consumer)
 
 	sender.Run()
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/notify"
+)
+
+// preview runs "sender preview <channel> [locale] [timezone]": it renders the templates of a channel
+// against a sample event and prints every rendered part, then returns the exit code.
+func preview(conf notify.Conf, channel, locale, timeZone string) int {
+	if channel == "" {
+		fmt.Fprintln(os.Stderr, "Usage: sender preview <channel> [locale] [timezone]")
+		return 1
+	}
+	if _, err := model.LoadLocation(timeZone); err != nil {
+		fmt.Fprintf(os.Stderr, "Wrong time zone:%v\n", err)
+		return 1
+	}
+	templates, err := notify.LoadTemplates(conf.Templates, conf.Locale)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Can't load notification templates:%v\n", err)
+		return 1
+	}
+
+	start := time.Now().UTC().Truncate(time.Hour).Add(24 * time.Hour)
+	event := model.Event{
+		ID:          1,
+		UserID:      1,
+		Title:       "Sample event",
+		Description: "The agenda of the sample event.",
+		OnTime:      start,
+		OffTime:     start.Add(time.Hour),
+	}
+	user := model.User{ID: 1, TimeZone: timeZone, Email: "user@example.com", Locale: locale}
+	msg := model.NotificationMsg{ID: event.ID, Title: event.Title, Date: event.OnTime, UserID: user.ID}
+
+	n, err := templates.Render(channel, notify.NewData(msg, event, user))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Can't render notification:%v\n", err)
+		return 1
+	}
+	printPart(os.Stdout, notify.PartSubject, n.Subject)
+	printPart(os.Stdout, notify.PartText, n.Body)
+	printPart(os.Stdout, notify.PartHTML, n.HTML)
+	printPart(os.Stdout, notify.PartJSON, string(n.Payload))
+	return 0
+}
+
+func printPart(w io.Writer, part, text string) {
+	if text == "" {
+		return
+	}
+	fmt.Fprintf(w, "--- %s\n%s\n", part, strings.TrimRight(text, "\n"))
+}
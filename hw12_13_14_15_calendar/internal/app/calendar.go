@@ -1,14 +1,27 @@
 package app
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"net/mail"
+	"os"
 	"os/signal"
+	"regexp"
+	"sort"
 	"syscall"
 	"time"
 
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/auth"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/ical"
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/logger"
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server"
@@ -20,6 +33,7 @@ import (
 type CalendarConf struct {
 	Logger  logger.Conf  `toml:"logger"`
 	Storage storage.Conf `toml:"storage"`
+	Auth    auth.Conf    `toml:"auth"`
 	HTTP    struct {
 		Host string `toml:"host"`
 		Port string `toml:"port"`
@@ -30,6 +44,15 @@ type CalendarConf struct {
 	} `toml:"grpc-server"`
 }
 
+const (
+	recurrenceBusyHorizon = 365 * 24 * time.Hour
+	feedTokenSize         = 24
+	maxConflicts          = 20
+)
+
+// localeRe matches the language tags of user locales: a language with optional subtags.
+var localeRe = regexp.MustCompile(`^[a-zA-Z]{2,3}(-[a-zA-Z0-9]{2,8})*$`)
+
 type Calendar struct {
 	conf    CalendarConf
 	log     server.Logger
@@ -45,7 +68,30 @@ type Storage interface {
 	GetEventByID(context.Context, int64) (model.Event, error)
 	GetAllEvents(context.Context, int64) ([]model.Event, error)
 	GetAllRange(context.Context, int64, time.Time, time.Time) ([]model.Event, error)
-	IsBusyDateTimeRange(context.Context, int64, int64, time.Time, time.Time) error
+	GetOverlaps(context.Context, int64, int64, time.Time, time.Time) ([]model.Event, error)
+	GetUser(context.Context, int64) (model.User, error)
+	UpsertUser(context.Context, *model.User) error
+	InsertFeedToken(context.Context, *model.FeedToken) error
+	UpdateFeedToken(context.Context, *model.FeedToken) error
+	DeleteFeedToken(context.Context, string) error
+	GetFeedToken(context.Context, string) (model.FeedToken, error)
+	GetFeedTokens(context.Context, int64) ([]model.FeedToken, error)
+	InsertCalendar(context.Context, *model.Calendar) error
+	UpdateCalendar(context.Context, *model.Calendar) error
+	DeleteCalendar(context.Context, int64) error
+	GetCalendar(context.Context, int64) (model.Calendar, error)
+	GetCalendars(context.Context, int64) ([]model.Calendar, error)
+	GetCalendarRange(context.Context, int64, time.Time, time.Time) ([]model.Event, error)
+	UpsertCalendarShare(context.Context, *model.CalendarShare) error
+	DeleteCalendarShare(context.Context, int64, int64) error
+	GetCalendarShare(context.Context, int64, int64) (model.CalendarShare, error)
+	GetCalendarShares(context.Context, int64) ([]model.CalendarShare, error)
+	SetAttendee(context.Context, int64, model.Attendee) error
+	DeleteAttendee(context.Context, int64, int64) error
+	GetInvitations(context.Context, int64) ([]model.Invitation, error)
+	GetEventHistory(context.Context, int64) ([]model.AuditEntry, error)
+	GetTrash(context.Context, int64) ([]model.Event, error)
+	RestoreEvent(context.Context, int64) error
 }
 
 type Server interface {
@@ -55,57 +101,248 @@ type Server interface {
 
 func (a *Calendar) CheckingEvent(e *model.Event, checkID bool) error {
 	if checkID && e.ID == 0 {
-		return fmt.Errorf("%w(ID is zero)", server.ErrID)
+		return server.NewError(server.ErrID, "(ID is zero)")
 	}
 	if e.UserID == 0 {
-		return fmt.Errorf("%w(UserID is %v)", server.ErrUserID, e.UserID)
+		return server.NewError(server.ErrUserID, "(UserID is %v)", e.UserID)
 	}
 
 	if len(e.Title) > 150 {
-		return fmt.Errorf("%w(len %v, must be <=150)", server.ErrTitle, len(e.Title))
+		return server.NewError(server.ErrTitle, "(len %v, must be <=150)", len(e.Title))
 	}
 
 	if e.OnTime.IsZero() {
-		return fmt.Errorf("%w(empty OnTime)", server.ErrOnTime)
+		return server.NewError(server.ErrOnTime, "(empty OnTime)")
 	}
 
 	switch {
 	case e.OffTime.IsZero():
-		return fmt.Errorf("%w: empty", server.ErrOffTime)
+		return server.NewError(server.ErrOffTime, ": empty")
 	case e.OffTime.Before(e.OnTime):
-		return fmt.Errorf("%w: before OnTime", server.ErrOffTime)
+		return server.NewError(server.ErrOffTime, ": before OnTime")
 	case e.OffTime.Equal(e.OnTime):
-		return fmt.Errorf("%w: equal OnTime", server.ErrOffTime)
+		return server.NewError(server.ErrOffTime, ": equal OnTime")
 	}
 
 	if !e.NotifyTime.IsZero() {
 		if e.NotifyTime.After(e.OffTime) {
-			return fmt.Errorf("%w(NotifyTime after OffTime)", server.ErrNotifyTime)
+			return server.NewError(server.ErrNotifyTime, "(NotifyTime after OffTime)")
+		}
+	}
+
+	if _, err := model.LoadLocation(e.TimeZone); err != nil {
+		return server.NewError(server.ErrTimeZone, "(%v)", err)
+	}
+
+	if !e.Status.Valid() {
+		return server.NewError(server.ErrStatus, "(%q, must be confirmed or tentative)", e.Status)
+	}
+
+	if e.IsRecurring() {
+		if _, err := e.RuleSet(); err != nil {
+			return server.NewError(server.ErrRRule, "(%v)", err)
+		}
+	} else if len(e.ExDates) != 0 || len(e.Overrides) != 0 {
+		return server.NewError(server.ErrRRule, "(exdates and overrides need RRule)")
+	}
+
+	invited := make(map[int64]bool, len(e.Attendees))
+	for _, attendee := range e.Attendees {
+		switch {
+		case attendee.UserID == 0:
+			return server.NewError(server.ErrAttendee, "(UserID is zero)")
+		case attendee.UserID == e.UserID:
+			return server.NewError(server.ErrAttendee, "(owner can't be invited)")
+		case invited[attendee.UserID]:
+			return server.NewError(server.ErrAttendee, "(user %v is invited twice)", attendee.UserID)
+		}
+		invited[attendee.UserID] = true
+	}
+
+	for _, o := range e.Overrides {
+		if o.RecurrenceID.IsZero() {
+			return server.NewError(server.ErrRRule, "(override without RecurrenceID)")
+		}
+		// the times an override leaves empty come from the occurrence it replaces
+		onTime, offTime := o.RecurrenceID, o.OffTime
+		if !o.OnTime.IsZero() {
+			onTime = o.OnTime
+		}
+		if offTime.IsZero() {
+			offTime = onTime.Add(e.OffTime.Sub(e.OnTime))
+		}
+		if !offTime.After(onTime) {
+			return server.NewError(server.ErrOffTime, ": override before OnTime")
 		}
 	}
 
 	return nil
 }
 
-func (a *Calendar) isBusyDateTimeRange(ctx context.Context, id, userID int64, onTime, offTime time.Time) error {
+// authorize allows the authenticated user to touch only own data, admins may touch anything.
+// Requests without a principal come from internal callers or from servers with authentication disabled.
+func (a *Calendar) authorize(ctx context.Context, userID int64) error {
+	p, ok := auth.FromContext(ctx)
+	if !ok || p.IsAdmin() || p.UserID == userID {
+		return nil
+	}
+	return server.NewError(server.ErrPermission, "(user %v can't access data of user %v)", p.UserID, userID)
+}
+
+// authorizeEvent checks the access of the authenticated user to the calendar of a stored event.
+func (a *Calendar) authorizeEvent(ctx context.Context, id int64, need model.Permission) error {
+	if _, ok := auth.FromContext(ctx); !ok || id == 0 {
+		return nil
+	}
+	e, err := a.storage.GetEventByID(ctx, id)
+	if err != nil {
+		return storageError(err)
+	}
+	_, err = a.authorizeCalendar(ctx, e.UserID, e.CalendarID, need)
+	return err
+}
+
+// storageError maps the errors of storage backends to the errors of the server package.
+func storageError(err error) error {
+	switch {
+	case storage.IsEventNotFound(err):
+		return server.NewError(server.ErrEventNotFound, "(%v)", err)
+	case storage.IsCalendarNotFound(err):
+		return server.NewError(server.ErrCalendarNotFound, "(%v)", err)
+	case storage.IsShareNotFound(err):
+		return server.NewError(server.ErrShareNotFound, "(%v)", err)
+	case storage.IsAttendeeNotFound(err):
+		return server.NewError(server.ErrInvitationNotFound, "(%v)", err)
+	case storage.IsVersionConflict(err):
+		return server.NewError(server.ErrVersionConflict, "(%v)", err)
+	}
+	return err
+}
+
+// conflictPolicy returns the policy of the event calendar, then the one of the owner, rejecting by default.
+func (a *Calendar) conflictPolicy(ctx context.Context, event *model.Event) (model.ConflictPolicy, error) {
+	if event.CalendarID != 0 {
+		c, err := a.storage.GetCalendar(ctx, event.CalendarID)
+		if err != nil {
+			return "", storageError(err)
+		}
+		if c.ConflictPolicy != "" {
+			return c.ConflictPolicy, nil
+		}
+	}
+	user, err := a.storage.GetUser(ctx, event.UserID)
+	if err != nil {
+		return "", err
+	}
+	if user.ConflictPolicy != "" {
+		return user.ConflictPolicy, nil
+	}
+	return model.ConflictPolicyReject, nil
+}
+
+// checkConflicts looks for other events of the owner overlapping the occurrences of the event within
+// recurrenceBusyHorizon. An overlap of two confirmed events follows the conflict policy, a tentative event
+// may overlap any event. Allowed overlaps are returned unless the policy is allow.
+func (a *Calendar) checkConflicts(ctx context.Context, event *model.Event) ([]model.Conflict, error) {
 	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
 	defer cancel()
-	return a.storage.IsBusyDateTimeRange(ctx, id, userID, onTime, offTime)
+	var conflicts []model.Conflict
+	policy, err := a.conflictPolicy(ctx, event)
+	if err != nil || policy == model.ConflictPolicyAllow {
+		return conflicts, err
+	}
+
+	occurrences, err := event.Occurrences(event.OnTime, event.OnTime.Add(recurrenceBusyHorizon))
+	if err != nil {
+		return conflicts, server.NewError(server.ErrRRule, "(%v)", err)
+	}
+	if len(occurrences) == 0 {
+		return conflicts, nil
+	}
+	begin, end := occurrences[0].OnTime, occurrences[0].OffTime
+	for _, o := range occurrences[1:] {
+		if o.OnTime.Before(begin) {
+			begin = o.OnTime
+		}
+		if o.OffTime.After(end) {
+			end = o.OffTime
+		}
+	}
+
+	// one lookup over the whole span, the overlaps are matched with the occurrences here
+	overlaps, err := a.storage.GetOverlaps(ctx, event.ID, event.UserID, begin, end)
+	if err != nil {
+		return conflicts, storageError(err)
+	}
+	for _, v := range overlaps {
+		if !overlapsAny(v, occurrences) {
+			continue
+		}
+		if policy == model.ConflictPolicyReject && !event.IsTentative() && !v.IsTentative() {
+			return conflicts, server.NewError(server.ErrDateBusy, "(overlaps event %v at %v)",
+				v.ID, v.OnTime.Format(time.RFC3339))
+		}
+		if len(conflicts) < maxConflicts {
+			conflicts = append(conflicts, model.Conflict{
+				EventID: v.ID, OnTime: v.OnTime, OffTime: v.OffTime, Status: v.Status,
+			})
+		}
+	}
+	return conflicts, nil
 }
 
-func (a *Calendar) firstDayOfWeek(t time.Time) time.Time {
-	for t.Weekday() != time.Monday {
-		t = t.AddDate(0, 0, -1)
+// overlapsAny reports whether an event overlaps one of the occurrences.
+func overlapsAny(e model.Event, occurrences []model.Event) bool {
+	for _, o := range occurrences {
+		if e.OnTime.Before(o.OffTime) && o.OnTime.Before(e.OffTime) {
+			return true
+		}
+	}
+	return false
+}
+
+// location resolves the zone of a request: the requested one, then the user default, then UTC.
+func (a *Calendar) location(ctx context.Context, userID int64, timeZone string) (*time.Location, error) {
+	if timeZone == "" {
+		user, err := a.storage.GetUser(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		timeZone = user.TimeZone
 	}
-	return t
+	loc, err := model.LoadLocation(timeZone)
+	if err != nil {
+		return nil, server.NewError(server.ErrTimeZone, "(%v)", err)
+	}
+	return loc, nil
+}
+
+// Boundaries are built with time.Date so that a day is 23 or 25 hours long on DST changes.
+func (a *Calendar) startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+func (a *Calendar) firstDayOfWeek(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d-(int(t.Weekday())+6)%7, 0, 0, 0, 0, t.Location())
 }
 
 func (a *Calendar) firstDayOfMonth(t time.Time) time.Time {
-	return t.AddDate(0, 0, -t.Day()+1)
+	y, m, _ := t.Date()
+	return time.Date(y, m, 1, 0, 0, 0, 0, t.Location())
 }
 
-func (a *Calendar) lastDayOfMonth(t time.Time) time.Time {
-	return t.AddDate(0, 1, -t.Day())
+// getAllRange returns events of [begin, next) with times presented in the zone of begin.
+func (a *Calendar) getAllRange(ctx context.Context, userID int64, begin, next time.Time) ([]model.Event, error) {
+	events, err := a.storage.GetAllRange(ctx, userID, begin, next)
+	if err != nil {
+		return nil, err
+	}
+	for i := range events {
+		events[i] = events[i].In(begin.Location())
+	}
+	return events, nil
 }
 
 func (a *Calendar) Close(ctx context.Context) error {
@@ -113,39 +350,92 @@ func (a *Calendar) Close(ctx context.Context) error {
 	return a.storage.Close(ctx)
 }
 
+// defaultTimeZone fills an empty event time zone with the default zone of the owner.
+func (a *Calendar) defaultTimeZone(ctx context.Context, event *model.Event) error {
+	if event.TimeZone != "" || event.UserID == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	user, err := a.storage.GetUser(ctx, event.UserID)
+	if err != nil {
+		return err
+	}
+	event.TimeZone = user.TimeZone
+	return nil
+}
+
 func (a *Calendar) InsertEvent(ctx context.Context, event *model.Event) error {
+	if err := a.checkEventCalendar(ctx, event); err != nil {
+		return err
+	}
+
+	if err := a.defaultTimeZone(ctx, event); err != nil {
+		return err
+	}
+
 	if err := a.CheckingEvent(event, false); err != nil {
 		return err
 	}
 
-	if err := a.isBusyDateTimeRange(ctx, event.ID, event.UserID, event.OnTime, event.OffTime); err != nil {
+	conflicts, err := a.checkConflicts(ctx, event)
+	if err != nil {
 		return err
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
 	defer cancel()
 
-	return a.storage.InsertEvent(ctx, event)
+	// attendees of a new event are invited, their responses are their own
+	for i := range event.Attendees {
+		event.Attendees[i].Status = model.PartStatNeedsAction
+	}
+	event.Conflicts = nil
+	if err := a.storage.InsertEvent(ctx, event); err != nil {
+		return err
+	}
+	event.Conflicts = conflicts
+	return nil
 }
 
 func (a *Calendar) UpdateEvent(ctx context.Context, event *model.Event) error {
+	if err := a.authorizeEvent(ctx, event.ID, model.PermissionWrite); err != nil {
+		return err
+	}
+	if err := a.checkEventCalendar(ctx, event); err != nil {
+		return err
+	}
+
+	if err := a.defaultTimeZone(ctx, event); err != nil {
+		return err
+	}
+
 	if err := a.CheckingEvent(event, true); err != nil {
 		return err
 	}
 
-	if err := a.isBusyDateTimeRange(ctx, event.ID, event.UserID, event.OnTime, event.OffTime); err != nil {
+	conflicts, err := a.checkConflicts(ctx, event)
+	if err != nil {
 		return err
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
 	defer cancel()
-	return a.storage.UpdateEvent(ctx, event)
+	event.Conflicts = nil
+	if err := a.storage.UpdateEvent(ctx, event); err != nil {
+		return storageError(err)
+	}
+	event.Conflicts = conflicts
+	return nil
 }
 
 func (a *Calendar) DeleteEvent(ctx context.Context, id int64) error {
+	if err := a.authorizeEvent(ctx, id, model.PermissionWrite); err != nil {
+		return err
+	}
 	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
 	defer cancel()
-	return a.storage.DeleteEvent(ctx, id)
+	return storageError(a.storage.DeleteEvent(ctx, id))
 }
 
 func (a *Calendar) GetEventByID(ctx context.Context, id int64) (model.Event, error) {
@@ -154,47 +444,276 @@ func (a *Calendar) GetEventByID(ctx context.Context, id int64) (model.Event, err
 	}
 	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
 	defer cancel()
-	return a.storage.GetEventByID(ctx, id)
+	e, err := a.storage.GetEventByID(ctx, id)
+	if err != nil {
+		return model.Event{}, storageError(err)
+	}
+	permission, err := a.authorizeCalendar(ctx, e.UserID, e.CalendarID, model.PermissionFreeBusy)
+	if err != nil {
+		if a.isAttendee(ctx, &e) {
+			return e, nil
+		}
+		return model.Event{}, err
+	}
+	if !permission.Allows(model.PermissionRead) {
+		return e.FreeBusy(), nil
+	}
+	return e, nil
 }
 
 func (a *Calendar) GetAllEvents(ctx context.Context, userID int64) ([]model.Event, error) {
 	if userID == 0 {
 		return []model.Event{}, server.ErrUserID
 	}
+	if err := a.authorize(ctx, userID); err != nil {
+		return []model.Event{}, err
+	}
 	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
 	defer cancel()
 	return a.storage.GetAllEvents(ctx, userID)
 }
 
-func (a *Calendar) GetAllEventsDay(ctx context.Context, userID int64, date time.Time) ([]model.Event, error) {
+func (a *Calendar) GetAllEventsDay(ctx context.Context, userID int64, date time.Time, timeZone string,
+) ([]model.Event, error) {
 	if userID == 0 {
 		return []model.Event{}, server.ErrUserID
 	}
+	if err := a.authorize(ctx, userID); err != nil {
+		return []model.Event{}, err
+	}
 	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
 	defer cancel()
-	return a.storage.GetAllRange(ctx, userID, date, date)
+	loc, err := a.location(ctx, userID, timeZone)
+	if err != nil {
+		return []model.Event{}, err
+	}
+	day := a.startOfDay(date.In(loc))
+	return a.getAllRange(ctx, userID, day, day.AddDate(0, 0, 1))
 }
 
-func (a *Calendar) GetAllEventsWeek(ctx context.Context, userID int64, date time.Time) ([]model.Event, error) {
+func (a *Calendar) GetAllEventsWeek(ctx context.Context, userID int64, date time.Time, timeZone string,
+) ([]model.Event, error) {
 	if userID == 0 {
 		return []model.Event{}, server.ErrUserID
 	}
+	if err := a.authorize(ctx, userID); err != nil {
+		return []model.Event{}, err
+	}
 	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
 	defer cancel()
-	monday := a.firstDayOfWeek(date)
-	sunday := monday.AddDate(0, 0, 6)
-	return a.storage.GetAllRange(ctx, userID, monday, sunday)
+	loc, err := a.location(ctx, userID, timeZone)
+	if err != nil {
+		return []model.Event{}, err
+	}
+	monday := a.firstDayOfWeek(date.In(loc))
+	return a.getAllRange(ctx, userID, monday, monday.AddDate(0, 0, 7))
 }
 
-func (a *Calendar) GetAllEventsMonth(ctx context.Context, userID int64, date time.Time) ([]model.Event, error) {
+func (a *Calendar) GetAllEventsMonth(ctx context.Context, userID int64, date time.Time, timeZone string,
+) ([]model.Event, error) {
 	if userID == 0 {
 		return []model.Event{}, server.ErrUserID
 	}
+	if err := a.authorize(ctx, userID); err != nil {
+		return []model.Event{}, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	loc, err := a.location(ctx, userID, timeZone)
+	if err != nil {
+		return []model.Event{}, err
+	}
+	dayFirst := a.firstDayOfMonth(date.In(loc))
+	return a.getAllRange(ctx, userID, dayFirst, dayFirst.AddDate(0, 1, 0))
+}
+
+// GetAllEventsRange returns events of [begin, end) with times presented in the zone of begin.
+func (a *Calendar) GetAllEventsRange(ctx context.Context, userID int64, begin, end time.Time,
+) ([]model.Event, error) {
+	if userID == 0 {
+		return []model.Event{}, server.ErrUserID
+	}
+	if err := a.authorize(ctx, userID); err != nil {
+		return []model.Event{}, err
+	}
+	if !end.After(begin) {
+		return []model.Event{}, server.NewError(server.ErrOffTime, "(end of range is not after begin)")
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	return a.getAllRange(ctx, userID, begin, end)
+}
+
+func (a *Calendar) GetUser(ctx context.Context, userID int64) (model.User, error) {
+	if userID == 0 {
+		return model.User{}, server.ErrUserID
+	}
+	if err := a.authorize(ctx, userID); err != nil {
+		return model.User{}, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	return a.storage.GetUser(ctx, userID)
+}
+
+func (a *Calendar) UpdateUser(ctx context.Context, user *model.User) error {
+	if user.ID == 0 {
+		return server.ErrUserID
+	}
+	if err := a.authorize(ctx, user.ID); err != nil {
+		return err
+	}
+	if _, err := model.LoadLocation(user.TimeZone); err != nil {
+		return server.NewError(server.ErrTimeZone, "(%v)", err)
+	}
+	if !user.ConflictPolicy.Valid() {
+		return server.NewError(server.ErrConflictPolicy,
+			"(%q, must be reject, allow or allow-with-warning)", user.ConflictPolicy)
+	}
+	if user.Email != "" {
+		if addr, err := mail.ParseAddress(user.Email); err != nil || addr.Address != user.Email {
+			return server.NewError(server.ErrEmail, "(%q is not a bare address)", user.Email)
+		}
+	}
+	if user.Locale != "" && !localeRe.MatchString(user.Locale) {
+		return server.NewError(server.ErrLocale, "(%q is not a language tag like en or pt-BR)", user.Locale)
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	return a.storage.UpsertUser(ctx, user)
+}
+
+// ImportEvents inserts the VEVENTs of an iCalendar document, events that fail validation are reported and skipped.
+func (a *Calendar) ImportEvents(ctx context.Context, userID int64, r io.Reader) (model.ImportReport, error) {
+	report := model.ImportReport{Items: []model.ImportItem{}}
+	if userID == 0 {
+		return report, server.ErrUserID
+	}
+	if err := a.authorize(ctx, userID); err != nil {
+		return report, err
+	}
+
+	entries, err := ical.Decode(r, userID)
+	if err != nil {
+		return report, server.NewError(server.ErrICalendar, "(%v)", err)
+	}
+
+	for _, entry := range entries {
+		if entry.Item.Error == "" {
+			event := entry.Event
+			if err := a.InsertEvent(ctx, &event); err != nil {
+				entry.Item.Error = err.Error()
+			} else {
+				entry.Item.EventID = event.ID
+				report.Imported++
+			}
+		}
+		report.Items = append(report.Items, entry.Item)
+	}
+	return report, nil
+}
+
+func (a *Calendar) ExportEvents(ctx context.Context, userID int64, w io.Writer) error {
+	events, err := a.GetAllEvents(ctx, userID)
+	if err != nil {
+		return err
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].OnTime.Before(events[j].OnTime) })
+	return ical.Encode(w, fmt.Sprintf("Calendar of user %d", userID), time.Now(), events)
+}
+
+// CreateFeedToken issues a token for the read-only iCalendar feed of a user.
+func (a *Calendar) CreateFeedToken(ctx context.Context, userID int64) (model.FeedToken, error) {
+	if userID == 0 {
+		return model.FeedToken{}, server.ErrUserID
+	}
+	if err := a.authorize(ctx, userID); err != nil {
+		return model.FeedToken{}, err
+	}
+	raw := make([]byte, feedTokenSize)
+	if _, err := rand.Read(raw); err != nil {
+		return model.FeedToken{}, fmt.Errorf("can't generate feed token: %w", err)
+	}
+	t := model.FeedToken{
+		Token:   base64.RawURLEncoding.EncodeToString(raw),
+		UserID:  userID,
+		Created: time.Now().UTC().Truncate(time.Second),
+	}
 	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
 	defer cancel()
-	dayFirst := a.firstDayOfMonth(date)
-	dayLast := a.lastDayOfMonth(date)
-	return a.storage.GetAllRange(ctx, userID, dayFirst, dayLast)
+	if err := a.storage.InsertFeedToken(ctx, &t); err != nil {
+		return model.FeedToken{}, err
+	}
+	return t, nil
+}
+
+func (a *Calendar) GetFeedTokens(ctx context.Context, userID int64) ([]model.FeedToken, error) {
+	if userID == 0 {
+		return []model.FeedToken{}, server.ErrUserID
+	}
+	if err := a.authorize(ctx, userID); err != nil {
+		return []model.FeedToken{}, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	return a.storage.GetFeedTokens(ctx, userID)
+}
+
+// RevokeFeedToken deletes a feed token, only its owner can revoke it.
+func (a *Calendar) RevokeFeedToken(ctx context.Context, userID int64, token string) error {
+	if userID == 0 {
+		return server.ErrUserID
+	}
+	if err := a.authorize(ctx, userID); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	t, err := a.storage.GetFeedToken(ctx, token)
+	if err != nil {
+		return server.NewError(server.ErrFeedToken, "(%v)", err)
+	}
+	if t.UserID != userID {
+		return server.NewError(server.ErrFeedToken, "(token of another user)")
+	}
+	return a.storage.DeleteFeedToken(ctx, token)
+}
+
+// GetFeed renders the iCalendar feed of a token. Modified only moves when the feed content changes,
+// so clients polling with If-None-Match or If-Modified-Since get the same validators for the same data.
+func (a *Calendar) GetFeed(ctx context.Context, token string) (model.Feed, error) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	t, err := a.storage.GetFeedToken(ctx, token)
+	if err != nil {
+		return model.Feed{}, server.NewError(server.ErrFeedToken, "(%v)", err)
+	}
+
+	events, err := a.storage.GetAllEvents(ctx, t.UserID)
+	if err != nil {
+		return model.Feed{}, err
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].ID < events[j].ID })
+
+	content, err := json.Marshal(events)
+	if err != nil {
+		return model.Feed{}, err
+	}
+	sum := sha256.Sum256(content)
+	etag := fmt.Sprintf("%q", hex.EncodeToString(sum[:16]))
+	if etag != t.ETag {
+		t.ETag = etag
+		t.Modified = time.Now().UTC().Truncate(time.Second)
+		if err := a.storage.UpdateFeedToken(ctx, &t); err != nil {
+			a.log.Warningf("Can't update feed token:%v\n", err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := ical.Encode(&buf, fmt.Sprintf("Calendar of user %d", t.UserID), t.Modified, events); err != nil {
+		return model.Feed{}, err
+	}
+	return model.Feed{UserID: t.UserID, ETag: t.ETag, Modified: t.Modified, Data: buf.Bytes()}, nil
 }
 
 func NewCalendar(log server.Logger, conf CalendarConf, storage Storage) *Calendar {
@@ -204,10 +723,31 @@ func NewCalendar(log server.Logger, conf CalendarConf, storage Storage) *Calenda
 	if err := storage.Connect(ctx); err != nil {
 		server.Exitfail(fmt.Sprintf("Can't connect to storage:%v", err))
 	}
+	checkSchema(storage, conf.Storage.Migrate)
 
 	return &Calendar{log: log, conf: conf, storage: storage}
 }
 
+// checkSchema applies the pending migrations when migrate is set and refuses to serve an outdated schema.
+// Storages without a schema are skipped.
+func checkSchema(s Storage, migrate bool) {
+	m, ok := s.(storage.Migrator)
+	if !ok {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if migrate {
+		if err := m.Migrate(ctx, "up", os.Stdout); err != nil {
+			server.Exitfail(fmt.Sprintf("Can't migrate storage:%v", err))
+		}
+	}
+	if err := m.CheckSchema(ctx); err != nil {
+		server.Exitfail(fmt.Sprintf("Can't serve: %v, run \"calendar migrate up\" or set migrate = true", err))
+	}
+}
+
 func (a Calendar) Run(httpsrv Server, grpcsrv Server) {
 	ctx, cancel := signal.NotifyContext(context.Background(),
 		syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
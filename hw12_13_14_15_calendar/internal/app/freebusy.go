@@ -0,0 +1,136 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server"
+)
+
+const (
+	freeBusyMaxRange  = 92 * 24 * time.Hour
+	slotsDefaultLimit = 3
+	slotsMaxLimit     = 50
+	workDayStart      = "09:00"
+	workDayEnd        = "18:00"
+)
+
+// checkFreeBusy validates the users and the window of a free/busy request and drops repeated users.
+func (a *Calendar) checkFreeBusy(userIDs []int64, begin, end time.Time) ([]int64, error) {
+	if len(userIDs) == 0 {
+		return nil, server.NewError(server.ErrUserID, "(no users)")
+	}
+	unique := make([]int64, 0, len(userIDs))
+	seen := make(map[int64]bool, len(userIDs))
+	for _, userID := range userIDs {
+		if userID == 0 {
+			return nil, server.NewError(server.ErrUserID, "(UserID is zero)")
+		}
+		if !seen[userID] {
+			seen[userID] = true
+			unique = append(unique, userID)
+		}
+	}
+	if !end.After(begin) {
+		return nil, server.NewError(server.ErrOffTime, "(end of range is not after begin)")
+	}
+	if end.Sub(begin) > freeBusyMaxRange {
+		return nil, server.NewError(server.ErrRequest, "(range is longer than %v)", freeBusyMaxRange)
+	}
+	return unique, nil
+}
+
+// busy returns the merged busy time of a user in [begin, end): own events and accepted invitations.
+func (a *Calendar) busy(ctx context.Context, userID int64, begin, end time.Time) ([]model.Interval, error) {
+	events, err := a.storage.GetAllRange(ctx, userID, begin, end)
+	if err != nil {
+		return nil, err
+	}
+	intervals := make([]model.Interval, 0, len(events))
+	for _, e := range events {
+		intervals = append(intervals, model.Interval{Start: e.OnTime, End: e.OffTime})
+	}
+	return model.ClipIntervals(model.MergeIntervals(intervals), begin, end), nil
+}
+
+// GetFreeBusy returns the busy time of users in [begin, end). It only tells when users are busy,
+// not what they do, so any authenticated user may ask for any users.
+func (a *Calendar) GetFreeBusy(ctx context.Context, userIDs []int64, begin, end time.Time) (model.FreeBusy, error) {
+	fb := model.FreeBusy{Begin: begin, End: end, Busy: []model.Interval{}, Users: []model.UserBusy{}}
+	userIDs, err := a.checkFreeBusy(userIDs, begin, end)
+	if err != nil {
+		return fb, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	var all []model.Interval
+	for _, userID := range userIDs {
+		busy, err := a.busy(ctx, userID, begin, end)
+		if err != nil {
+			return fb, err
+		}
+		fb.Users = append(fb.Users, model.UserBusy{UserID: userID, Busy: busy})
+		all = append(all, busy...)
+	}
+	fb.Busy = model.MergeIntervals(all)
+	return fb, nil
+}
+
+// parseClock converts a "15:04" local time to minutes from midnight.
+func parseClock(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// FindSlots proposes the earliest slots in the window of the query when all users are free
+// within the working hours, 09:00-18:00 unless the query names others.
+func (a *Calendar) FindSlots(ctx context.Context, query model.SlotQuery) ([]model.Interval, error) {
+	if query.Minutes <= 0 {
+		return []model.Interval{}, server.NewError(server.ErrDuration, "(%v minutes)", query.Minutes)
+	}
+	if query.WorkStart == "" {
+		query.WorkStart = workDayStart
+	}
+	if query.WorkEnd == "" {
+		query.WorkEnd = workDayEnd
+	}
+	workStart, err := parseClock(query.WorkStart)
+	if err != nil {
+		return []model.Interval{}, server.NewError(server.ErrWorkingHours, "(%v)", err)
+	}
+	workEnd, err := parseClock(query.WorkEnd)
+	if err != nil {
+		return []model.Interval{}, server.NewError(server.ErrWorkingHours, "(%v)", err)
+	}
+	if workEnd <= workStart {
+		return []model.Interval{}, server.NewError(server.ErrWorkingHours, "(%v is not after %v)",
+			query.WorkEnd, query.WorkStart)
+	}
+	switch {
+	case query.Limit <= 0:
+		query.Limit = slotsDefaultLimit
+	case query.Limit > slotsMaxLimit:
+		query.Limit = slotsMaxLimit
+	}
+
+	fb, err := a.GetFreeBusy(ctx, query.UserIDs, query.Begin, query.End)
+	if err != nil {
+		return []model.Interval{}, err
+	}
+	loc, err := a.location(ctx, query.UserIDs[0], query.TimeZone)
+	if err != nil {
+		return []model.Interval{}, err
+	}
+
+	slots := model.FreeSlots(fb.Busy, query.Begin, query.End, time.Duration(query.Minutes)*time.Minute,
+		workStart, workEnd, loc, query.Limit)
+	for i := range slots {
+		slots[i] = model.Interval{Start: slots[i].Start.In(loc), End: slots[i].End.In(loc)}
+	}
+	return slots, nil
+}
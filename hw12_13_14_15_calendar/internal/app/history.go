@@ -0,0 +1,36 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server"
+)
+
+// GetEventHistory returns the changes of an event from the oldest. It needs read access to the calendar
+// of the event, the history of a deleted event is left to its owner.
+func (a *Calendar) GetEventHistory(ctx context.Context, id int64) ([]model.AuditEntry, error) {
+	if id == 0 {
+		return nil, server.ErrID
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	entries, err := a.storage.GetEventHistory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, server.NewError(server.ErrEventNotFound, "(no history of event %v)", id)
+	}
+	last := entries[len(entries)-1]
+	if last.After == nil {
+		err = a.authorize(ctx, last.Before.UserID)
+	} else {
+		_, err = a.authorizeCalendar(ctx, last.After.UserID, last.After.CalendarID, model.PermissionRead)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
@@ -0,0 +1,96 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/auth"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server"
+)
+
+// isAttendee reports whether the authenticated user is invited to an event.
+func (a *Calendar) isAttendee(ctx context.Context, e *model.Event) bool {
+	p, ok := auth.FromContext(ctx)
+	if !ok {
+		return false
+	}
+	_, invited := e.Attendee(p.UserID)
+	return invited
+}
+
+// InviteAttendee invites a user to an event, a user already invited keeps the response.
+func (a *Calendar) InviteAttendee(ctx context.Context, eventID, userID int64) error {
+	if userID == 0 {
+		return server.ErrUserID
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	e, err := a.storage.GetEventByID(ctx, eventID)
+	if err != nil {
+		return storageError(err)
+	}
+	if _, err := a.authorizeCalendar(ctx, e.UserID, e.CalendarID, model.PermissionWrite); err != nil {
+		return err
+	}
+	if userID == e.UserID {
+		return server.NewError(server.ErrAttendee, "(owner can't be invited)")
+	}
+	if _, ok := e.Attendee(userID); ok {
+		return nil
+	}
+	attendee := model.Attendee{UserID: userID, Status: model.PartStatNeedsAction}
+	return storageError(a.storage.SetAttendee(ctx, eventID, attendee))
+}
+
+// RespondInvitation records the response of an attendee, only the attendee can respond.
+func (a *Calendar) RespondInvitation(ctx context.Context, eventID, userID int64, status model.PartStat) error {
+	if userID == 0 {
+		return server.ErrUserID
+	}
+	if err := a.authorize(ctx, userID); err != nil {
+		return err
+	}
+	if !status.Valid() {
+		return server.NewError(server.ErrPartStat,
+			"(%q, must be needs-action, accepted, declined or tentative)", status)
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	e, err := a.storage.GetEventByID(ctx, eventID)
+	if err != nil {
+		return storageError(err)
+	}
+	if _, ok := e.Attendee(userID); !ok {
+		return server.NewError(server.ErrInvitationNotFound, "(user %v is not invited to event %v)", userID, eventID)
+	}
+	return storageError(a.storage.SetAttendee(ctx, eventID, model.Attendee{UserID: userID, Status: status}))
+}
+
+// RemoveAttendee withdraws an invitation, the organizer and the attendee can remove it.
+func (a *Calendar) RemoveAttendee(ctx context.Context, eventID, userID int64) error {
+	if userID == 0 {
+		return server.ErrUserID
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	if p, ok := auth.FromContext(ctx); !ok || p.UserID != userID {
+		if err := a.authorizeEvent(ctx, eventID, model.PermissionWrite); err != nil {
+			return err
+		}
+	}
+	return storageError(a.storage.DeleteAttendee(ctx, eventID, userID))
+}
+
+// GetInvitations returns the events a user is invited to with the response of the user.
+func (a *Calendar) GetInvitations(ctx context.Context, userID int64) ([]model.Invitation, error) {
+	if userID == 0 {
+		return []model.Invitation{}, server.ErrUserID
+	}
+	if err := a.authorize(ctx, userID); err != nil {
+		return []model.Invitation{}, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	return a.storage.GetInvitations(ctx, userID)
+}
@@ -0,0 +1,58 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+)
+
+// defaultOutboxRetention is how long published notifications stay in the outbox
+// when the config sets no retention.
+const defaultOutboxRetention = 7 * 24 * time.Hour
+
+// EnqueueNotifications writes the notifications due at date to the outbox, each of them once.
+func (s Scheduler) EnqueueNotifications(ctx context.Context, date time.Time) (int64, error) {
+	enqueued, err := s.storage.EnqueueNotifications(model.WithActor(ctx, actorScheduler), date)
+	if err != nil {
+		return enqueued, fmt.Errorf("EnqueueNotifications:%w", err)
+	}
+	return enqueued, nil
+}
+
+// RelayOutbox publishes the notifications of the outbox in order and marks them sent.
+// It stops at the first notification the broker refuses, the next call publishes it again.
+func (s Scheduler) RelayOutbox(ctx context.Context) (int64, error) {
+	sent := int64(0)
+	for {
+		entries, err := s.storage.GetOutbox(ctx, s.batchSize())
+		if err != nil {
+			return sent, fmt.Errorf("GetOutbox:%w", err)
+		}
+		for i := range entries {
+			if err := s.producer.SendNotification(ctx, &entries[i].Msg); err != nil {
+				if err := s.storage.MarkOutboxFailed(ctx, entries[i].ID, err.Error()); err != nil {
+					s.log.Errorf("MarkOutboxFailed:%v\n", err)
+				}
+				return sent, fmt.Errorf("SendNotification:%w", err)
+			}
+			if err := s.storage.MarkOutboxSent(ctx, entries[i].ID); err != nil {
+				return sent, fmt.Errorf("MarkOutboxSent:%w", err)
+			}
+			sent++
+		}
+		if len(entries) < s.batchSize() {
+			return sent, nil
+		}
+	}
+}
+
+// PurgeOutbox removes the notifications published longer than the outbox retention before date.
+func (s Scheduler) PurgeOutbox(ctx context.Context, date time.Time) (int64, error) {
+	retention := s.conf.OutboxRetention
+	if retention <= 0 {
+		retention = defaultOutboxRetention
+	}
+	return s.storage.PurgeOutbox(ctx, date.Add(-retention))
+}
@@ -0,0 +1,145 @@
+package app
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+)
+
+const (
+	// defaultRetention is how long finished events are kept when the config sets no retention.
+	defaultRetention = 365 * 24 * time.Hour
+	// defaultBatchSize is how many events are removed at once when the config sets no batch size.
+	defaultBatchSize = 500
+)
+
+func (s Scheduler) retention() time.Duration {
+	if s.conf.Retention <= 0 {
+		return defaultRetention
+	}
+	return s.conf.Retention
+}
+
+func (s Scheduler) batchSize() int {
+	if s.conf.BatchSize <= 0 {
+		return defaultBatchSize
+	}
+	return s.conf.BatchSize
+}
+
+// DeleteEventsOlderDate moves the events ended before date to the trash in batches and returns how many
+// were moved, the audit log names the scheduler as the actor. Every batch is archived before it is deleted,
+// so a failed archive keeps the events. A dry run only logs and counts the events.
+func (s Scheduler) DeleteEventsOlderDate(ctx context.Context, date time.Time) (int64, error) {
+	ctx = model.WithActor(ctx, actorScheduler)
+	if s.conf.DryRun {
+		events, err := s.storage.GetEventsOlderDate(ctx, date, 0)
+		if err != nil {
+			return 0, err
+		}
+		for i := range events {
+			s.log.Infof("Dry run, event would be deleted id:%v title:%q offtime:%v\n",
+				events[i].ID, events[i].Title, events[i].OffTime)
+		}
+		return int64(len(events)), nil
+	}
+
+	var archive *eventArchive
+	defer func() {
+		if archive == nil {
+			return
+		}
+		if err := archive.Close(); err != nil {
+			s.log.Errorf("%v", err)
+		}
+	}()
+
+	deleted := int64(0)
+	for {
+		events, err := s.storage.GetEventsOlderDate(ctx, date, s.batchSize())
+		if err != nil {
+			return deleted, err
+		}
+		if len(events) == 0 {
+			return deleted, nil
+		}
+
+		if s.conf.Archive != "" {
+			if archive == nil {
+				if archive, err = openEventArchive(s.conf.Archive, time.Now()); err != nil {
+					return deleted, err
+				}
+			}
+			if err := archive.Write(events); err != nil {
+				return deleted, err
+			}
+		}
+
+		ids := make([]int64, 0, len(events))
+		for i := range events {
+			ids = append(ids, events[i].ID)
+		}
+		n, err := s.storage.DeleteEvents(ctx, ids)
+		deleted += n
+		if err != nil {
+			return deleted, err
+		}
+		// a short batch was the last one, nothing deleted means the events went away meanwhile
+		if len(events) < s.batchSize() || n == 0 {
+			return deleted, nil
+		}
+	}
+}
+
+// eventArchive writes events to a gzipped JSON lines file, one event per line.
+type eventArchive struct {
+	file *os.File
+	gz   *gzip.Writer
+	enc  *json.Encoder
+}
+
+func openEventArchive(dir string, now time.Time) (*eventArchive, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("can't create archive directory:%w", err)
+	}
+	name := filepath.Join(dir, "events-"+now.UTC().Format("20060102T150405.000000000Z")+".jsonl.gz")
+	file, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("can't create archive:%w", err)
+	}
+	gz := gzip.NewWriter(file)
+	return &eventArchive{file: file, gz: gz, enc: json.NewEncoder(gz)}, nil
+}
+
+// Write appends events and flushes them to the file, so they are archived before they are deleted.
+func (a *eventArchive) Write(events []model.Event) error {
+	for i := range events {
+		if err := a.enc.Encode(events[i]); err != nil {
+			return fmt.Errorf("can't archive event %v:%w", events[i].ID, err)
+		}
+	}
+	if err := a.gz.Flush(); err != nil {
+		return fmt.Errorf("can't archive events:%w", err)
+	}
+	if err := a.file.Sync(); err != nil {
+		return fmt.Errorf("can't archive events:%w", err)
+	}
+	return nil
+}
+
+func (a *eventArchive) Close() error {
+	if err := a.gz.Close(); err != nil {
+		a.file.Close()
+		return fmt.Errorf("can't close archive:%w", err)
+	}
+	if err := a.file.Close(); err != nil {
+		return fmt.Errorf("can't close archive:%w", err)
+	}
+	return nil
+}
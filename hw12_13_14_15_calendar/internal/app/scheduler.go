@@ -11,13 +11,34 @@ import (
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server"
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/storage"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/transport"
 )
 
+// actorScheduler names the scheduler in the audit log.
+const actorScheduler = "scheduler"
+
+// defaultTrashRetention is how long deleted events stay in the trash when the config sets no retention.
+const defaultTrashRetention = 30 * 24 * time.Hour
+
 type SchedulerConf struct {
 	Logger  logger.Conf   `toml:"logger"`
 	Storage storage.Conf  `toml:"storage"`
 	URLRMQ  string        `toml:"url_rmq"`
 	Period  time.Duration `toml:"period"`
+	// Broker selects the broker the notifications are published to.
+	Broker transport.Conf `toml:"broker"`
+	// TrashRetention is how long deleted events can be restored before the scheduler purges them.
+	TrashRetention time.Duration `toml:"trash_retention"`
+	// Retention is how long finished events are kept before the scheduler moves them to the trash.
+	Retention time.Duration `toml:"retention"`
+	// Archive is a directory the removed events are written to as gzipped JSON lines, none when empty.
+	Archive string `toml:"archive"`
+	// DryRun only reports the events the scheduler would remove, the trash and the outbox are not purged.
+	DryRun bool `toml:"dry_run"`
+	// BatchSize is how many events are removed by one statement.
+	BatchSize int `toml:"batch_size"`
+	// OutboxRetention is how long published notifications are kept in the outbox.
+	OutboxRetention time.Duration `toml:"outbox_retention"`
 }
 
 type Scheduler struct {
@@ -30,14 +51,18 @@ type Scheduler struct {
 type SchedulerStorage interface {
 	Connect(context.Context) error
 	Close(context.Context) error
-	GetEventsDayOfNotice(context.Context, time.Time) ([]model.Event, error)
-	DeleteEventsOlderDate(context.Context, time.Time) (int64, error)
+	EnqueueNotifications(context.Context, time.Time) (int64, error)
+	GetOutbox(context.Context, int) ([]model.OutboxEntry, error)
+	MarkOutboxSent(context.Context, int64) error
+	MarkOutboxFailed(context.Context, int64, string) error
+	PurgeOutbox(context.Context, time.Time) (int64, error)
+	GetEventsOlderDate(context.Context, time.Time, int) ([]model.Event, error)
+	DeleteEvents(context.Context, []int64) (int64, error)
+	PurgeTrash(context.Context, time.Time) (int64, error)
 }
 
 type SchedulerProducer interface {
-	Connect(context.Context) error
-	Close(context.Context) error
-	SendNotification(context.Context, *model.Event) error
+	transport.Publisher
 }
 
 func NewScheduler(log server.Logger, conf SchedulerConf, storage SchedulerStorage, producer SchedulerProducer,
@@ -58,7 +83,7 @@ func NewScheduler(log server.Logger, conf SchedulerConf, storage SchedulerStorag
 	}
 
 	if err := producer.Connect(ctx); err != nil {
-		server.Exitfail(fmt.Sprintf("Can't connect to RabbitMQ:%v", err))
+		server.Exitfail(fmt.Sprintf("Can't connect to broker:%v", err))
 	}
 
 	return scheduler
@@ -81,27 +106,56 @@ func (s Scheduler) Run() {
 			return
 
 		case <-ticker.C:
-			date := time.Now()
-			s.log.Debugf("Starting notification process...\n")
-			sent, err := s.SendNotification(ctx, date)
-			if err != nil {
-				s.log.Errorf("%v", err)
-				return
-			}
-			s.log.Debugf("Notifications  sent:%v\n", sent)
-
-			s.log.Debugf("Starting to remove events that are older than a year\n")
-			deleted, err := s.DeleteEventsOlderDate(ctx, date.AddDate(-1, 0, 0))
-			if err != nil {
-				s.log.Errorf("%v", err)
-				return
-			}
-			s.log.Debugf("Old events deleted:%v\n", deleted)
-			s.log.Debugf("Notification process has finished\n")
+			s.tick(ctx, time.Now())
 		}
 	}
 }
 
+// tick runs every step of the scheduler once, a failed step is logged and the next tick runs it again.
+func (s Scheduler) tick(ctx context.Context, date time.Time) {
+	s.log.Debugf("Starting notification process...\n")
+	enqueued, err := s.EnqueueNotifications(ctx, date)
+	if err != nil {
+		s.log.Errorf("%v\n", err)
+	}
+	s.log.Debugf("Notifications enqueued:%v\n", enqueued)
+
+	sent, err := s.RelayOutbox(ctx)
+	if err != nil {
+		s.log.Errorf("%v\n", err)
+	}
+	s.log.Debugf("Notifications sent:%v\n", sent)
+
+	s.log.Debugf("Starting to remove events that are older than %v\n", s.retention())
+	deleted, err := s.DeleteEventsOlderDate(ctx, date.Add(-s.retention()))
+	switch {
+	case err != nil:
+		s.log.Errorf("%v\n", err)
+	case s.conf.DryRun:
+		s.log.Infof("Dry run, old events would be deleted:%v\n", deleted)
+	default:
+		s.log.Debugf("Old events deleted:%v\n", deleted)
+	}
+	if s.conf.DryRun {
+		s.log.Infof("Dry run, the trash and the outbox are not purged\n")
+		s.log.Debugf("Notification process has finished\n")
+		return
+	}
+
+	purged, err := s.PurgeTrash(ctx, date)
+	if err != nil {
+		s.log.Errorf("%v\n", err)
+	}
+	s.log.Debugf("Trashed events purged:%v\n", purged)
+
+	purged, err = s.PurgeOutbox(ctx, date)
+	if err != nil {
+		s.log.Errorf("%v\n", err)
+	}
+	s.log.Debugf("Sent notifications purged:%v\n", purged)
+	s.log.Debugf("Notification process has finished\n")
+}
+
 func (s Scheduler) Stop(ctx context.Context) {
 	s.producer.Close(ctx)
 	s.log.Debugf("Producer closed\n")
@@ -109,23 +163,11 @@ func (s Scheduler) Stop(ctx context.Context) {
 	s.log.Debugf("Storage closed\n")
 }
 
-func (s Scheduler) DeleteEventsOlderDate(ctx context.Context, date time.Time) (int64, error) {
-	return s.storage.DeleteEventsOlderDate(ctx, date)
-}
-
-func (s Scheduler) SendNotification(ctx context.Context, date time.Time) (int64, error) {
-	sent := int64(0)
-	events, err := s.storage.GetEventsDayOfNotice(ctx, date)
-	if err != nil {
-		return sent, err
-	}
-
-	for i := range events {
-		err := s.producer.SendNotification(ctx, &events[i])
-		if err != nil {
-			return sent, fmt.Errorf("SendNotification:%w", err)
-		}
-		sent++
+// PurgeTrash removes for good the events deleted longer than the trash retention before date.
+func (s Scheduler) PurgeTrash(ctx context.Context, date time.Time) (int64, error) {
+	retention := s.conf.TrashRetention
+	if retention <= 0 {
+		retention = defaultTrashRetention
 	}
-	return sent, nil
+	return s.storage.PurgeTrash(model.WithActor(ctx, actorScheduler), date.Add(-retention))
 }
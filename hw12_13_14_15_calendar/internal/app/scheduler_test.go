@@ -0,0 +1,255 @@
+package app
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/logger"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/notify"
+	memorystorage "github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/storage/memory"
+	memorybroker "github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/transport/memory"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/transport/queue"
+	"github.com/stretchr/testify/require"
+)
+
+// notifyFixture holds what the scheduler and sender tests share.
+type notifyFixture struct {
+	ctx       context.Context
+	db        *memorystorage.Storage
+	log       *logger.Logger
+	templates *notify.Templates
+}
+
+func newNotifyFixture(t *testing.T) notifyFixture {
+	t.Helper()
+	templates, err := notify.LoadTemplates("", "")
+	require.NoError(t, err)
+	// the ids are shared by every memory storage and TestCalendarHTTPServer runs later expecting the first ones
+	genID := memorystorage.GenID
+	t.Cleanup(func() { memorystorage.GenID = genID })
+	return notifyFixture{
+		ctx:       context.Background(),
+		db:        memorystorage.New(),
+		log:       logger.NewLogger("DEBUG", os.Stdout),
+		templates: templates,
+	}
+}
+
+// dueEvent inserts an event of the user starting in an hour whose notification is due now.
+func (f notifyFixture) dueEvent(t *testing.T, userID int64, title string) model.Event {
+	t.Helper()
+	e := model.Event{
+		UserID:     userID,
+		Title:      title,
+		OnTime:     time.Now().Add(time.Hour),
+		OffTime:    time.Now().Add(2 * time.Hour),
+		NotifyTime: time.Now(),
+	}
+	require.NoError(t, f.db.InsertEvent(f.ctx, &e))
+	return e
+}
+
+// notified reports whether the last change of the event marked it notified.
+func (f notifyFixture) notified(t *testing.T, id int64) bool {
+	t.Helper()
+	history, err := f.db.GetEventHistory(f.ctx, id)
+	require.NoError(t, err)
+	return history[len(history)-1].Action == model.AuditNotified
+}
+
+func (f notifyFixture) scheduler(producer SchedulerProducer) Scheduler {
+	return Scheduler{log: f.log, storage: f.db, producer: producer}
+}
+
+func (f notifyFixture) sender(channels ...notify.Channel) Sender {
+	return Sender{log: f.log, storage: f.db, channels: channels, templates: f.templates}
+}
+
+type recordingProducer struct {
+	userIDs []int64
+	err     error
+}
+
+func (p *recordingProducer) Connect(context.Context) error { return nil }
+
+func (p *recordingProducer) Close(context.Context) error { return nil }
+
+func (p *recordingProducer) SendNotification(_ context.Context, msg *model.NotificationMsg) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.userIDs = append(p.userIDs, msg.UserID)
+	return nil
+}
+
+func TestSchedulerRetention(t *testing.T) {
+	f := newNotifyFixture(t)
+	ctx, db := f.ctx, f.db
+	date := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	var old []int64
+	for i := 0; i < 3; i++ {
+		e := model.Event{
+			UserID:  980,
+			Title:   "old",
+			OnTime:  date.AddDate(-2, 0, i),
+			OffTime: date.AddDate(-2, 0, i).Add(time.Hour),
+		}
+		require.NoError(t, db.InsertEvent(ctx, &e))
+		old = append(old, e.ID)
+	}
+	recent := model.Event{UserID: 980, Title: "recent", OnTime: date.AddDate(0, -1, 0), OffTime: date.AddDate(0, -1, 0)}
+	require.NoError(t, db.InsertEvent(ctx, &recent))
+
+	// a dry run removes nothing
+	dir := t.TempDir()
+	conf := SchedulerConf{Retention: 365 * 24 * time.Hour, BatchSize: 2, Archive: dir, DryRun: true}
+	scheduler := f.scheduler(nil)
+	scheduler.conf = conf
+	deleted, err := scheduler.DeleteEventsOlderDate(ctx, date.Add(-scheduler.retention()))
+	require.NoError(t, err)
+	require.Equal(t, int64(3), deleted)
+	events, err := db.GetAllEvents(ctx, 980)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+
+	// two batches go to one archive
+	scheduler.conf.DryRun = false
+	deleted, err = scheduler.DeleteEventsOlderDate(ctx, date.Add(-scheduler.retention()))
+	require.NoError(t, err)
+	require.Equal(t, int64(3), deleted)
+	events, err = db.GetAllEvents(ctx, 980)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, recent.ID, events[0].ID)
+
+	names, err := filepath.Glob(filepath.Join(dir, "events-*.jsonl.gz"))
+	require.NoError(t, err)
+	require.Len(t, names, 1)
+	file, err := os.Open(names[0])
+	require.NoError(t, err)
+	defer file.Close()
+	gz, err := gzip.NewReader(file)
+	require.NoError(t, err)
+	var archived []int64
+	scanner := bufio.NewScanner(gz)
+	for scanner.Scan() {
+		var e model.Event
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+		archived = append(archived, e.ID)
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, old, archived)
+
+	history, err := db.GetEventHistory(ctx, old[0])
+	require.NoError(t, err)
+	require.Equal(t, "scheduler", history[len(history)-1].Actor)
+
+	// nothing left to remove, no archive is created
+	deleted, err = scheduler.DeleteEventsOlderDate(ctx, date.Add(-scheduler.retention()))
+	require.NoError(t, err)
+	require.Zero(t, deleted)
+	files, err = os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	// a dry run purges nothing from the trash
+	scheduler.conf.DryRun = true
+	scheduler.tick(ctx, time.Now().AddDate(0, 2, 0))
+	trash, err := db.GetTrash(ctx, 980)
+	require.NoError(t, err)
+	require.Len(t, trash, 3)
+	events, err = db.GetAllEvents(ctx, 980)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	scheduler.conf.DryRun = false
+	scheduler.tick(ctx, time.Now().AddDate(0, 2, 0))
+	trash, err = db.GetTrash(ctx, 980)
+	require.NoError(t, err)
+	require.Empty(t, trash)
+}
+
+func TestSchedulerOutbox(t *testing.T) {
+	f := newNotifyFixture(t)
+	ctx, db := f.ctx, f.db
+	f.dueEvent(t, 992, "outbox")
+
+	// a broker outage keeps the notification in the outbox
+	producer := &recordingProducer{err: errors.New("down")}
+	scheduler := f.scheduler(producer)
+	enqueued, err := scheduler.EnqueueNotifications(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), enqueued)
+	sent, err := scheduler.RelayOutbox(ctx)
+	require.Error(t, err)
+	require.Zero(t, sent)
+	entries, err := db.GetOutbox(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, 1, entries[0].Attempts)
+	require.Equal(t, "down", entries[0].Error)
+
+	producer.err = nil
+	sent, err = scheduler.RelayOutbox(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), sent)
+	msg := entries[0].Msg
+	require.NotEmpty(t, msg.Key)
+
+	// a notification published twice is delivered once
+	delivered := &fakeChannel{}
+	sender := f.sender(delivered)
+	require.NoError(t, sender.Notify(ctx, msg))
+	require.NoError(t, sender.Notify(ctx, msg))
+	require.Len(t, delivered.sent, 1)
+
+	purged, err := scheduler.PurgeOutbox(ctx, time.Now())
+	require.NoError(t, err)
+	require.Zero(t, purged)
+	purged, err = scheduler.PurgeOutbox(ctx, time.Now().Add(defaultOutboxRetention+time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), purged)
+}
+
+func TestSchedulerToSender(t *testing.T) {
+	f := newNotifyFixture(t)
+	ctx := f.ctx
+	e := f.dueEvent(t, 993, "broker")
+
+	// the scheduler and the sender exchange the notification through the broker of the process
+	broker := memorybroker.New()
+	consumer := memorybroker.NewConsumer(broker, queue.Conf{})
+	require.NoError(t, consumer.Connect(ctx))
+	defer consumer.Close(ctx)
+	scheduler := f.scheduler(broker)
+	delivered := &fakeChannel{}
+	sender := f.sender(delivered)
+	sender.consumer = consumer
+
+	enqueued, err := scheduler.EnqueueNotifications(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), enqueued)
+	sent, err := scheduler.RelayOutbox(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), sent)
+
+	select {
+	case d := <-sender.consumer.NotifyChannel():
+		sender.handle(ctx, d)
+	case <-time.After(time.Second):
+		require.FailNow(t, "no notification received")
+	}
+	require.Len(t, delivered.sent, 1)
+	require.True(t, f.notified(t, e.ID))
+}
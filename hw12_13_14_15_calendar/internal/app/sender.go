@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os/signal"
 	"syscall"
@@ -9,54 +10,84 @@ import (
 
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/logger"
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/notify"
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server"
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/storage"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/transport"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/transport/queue"
 )
 
+// actorSender names the sender in the audit log.
+const actorSender = "sender"
+
+// deliveryTimeout bounds the delivery of a notification through one channel.
+const deliveryTimeout = 10 * time.Second
+
+var ErrNotDelivered = errors.New("notification not delivered")
+
 type SenderConf struct {
 	Logger  logger.Conf  `toml:"logger"`
 	Storage storage.Conf `toml:"storage"`
 	URLRMQ  string       `toml:"url_rmq"`
+	Notify  notify.Conf  `toml:"notify"`
+	// Broker selects the broker the notifications are received from.
+	Broker transport.Conf `toml:"broker"`
+	// Queue bounds the retries of the notifications that were not delivered.
+	Queue queue.Conf `toml:"queue"`
 }
 
 type Sender struct {
-	conf     SenderConf
-	log      server.Logger
-	storage  SenderStorage
-	consumer SenderConsumer
+	conf      SenderConf
+	log       server.Logger
+	storage   SenderStorage
+	consumer  SenderConsumer
+	channels  []notify.Channel
+	templates *notify.Templates
 }
 
 type SenderStorage interface {
 	Connect(context.Context) error
 	Close(context.Context) error
 
+	GetEventByID(context.Context, int64) (model.Event, error)
+	GetUser(context.Context, int64) (model.User, error)
 	UpdateEventNotified(context.Context, int64) error
+	IsNotificationDelivered(context.Context, string) (bool, error)
+	MarkNotificationDelivered(context.Context, string) error
 }
 
 type SenderConsumer interface {
-	Connect(context.Context) error
-	Close(context.Context) error
-	NotifyChannel() <-chan model.NotificationMsg
+	transport.Subscriber
 }
 
 func NewSender(log server.Logger, conf SenderConf, storage SenderStorage, consumer SenderConsumer) *Sender {
+	channels, err := notify.New(conf.Notify, log)
+	if err != nil {
+		server.Exitfail(fmt.Sprintf("Can't create notification channels:%v", err))
+	}
+	templates, err := notify.LoadTemplates(conf.Notify.Templates, conf.Notify.Locale)
+	if err != nil {
+		server.Exitfail(fmt.Sprintf("Can't load notification templates:%v", err))
+	}
 	sender := &Sender{
-		conf:     conf,
-		log:      log,
-		storage:  storage,
-		consumer: consumer,
+		conf:      conf,
+		log:       log,
+		storage:   storage,
+		consumer:  consumer,
+		channels:  channels,
+		templates: templates,
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	err := storage.Connect(ctx)
+	err = storage.Connect(ctx)
 	if err != nil {
 		server.Exitfail(fmt.Sprintf("Can't connect to storage:%v", err))
 	}
 
 	if err := consumer.Connect(ctx); err != nil {
-		server.Exitfail(fmt.Sprintf("Can't connect to RabbitMQ:%v", err))
+		server.Exitfail(fmt.Sprintf("Can't connect to broker:%v", err))
 	}
 
 	return sender
@@ -75,14 +106,9 @@ func (s Sender) Run() {
 			s.Stop(ctxStop)
 			return
 
-		case msg, ok := <-s.consumer.NotifyChannel():
+		case d, ok := <-s.consumer.NotifyChannel():
 			if ok {
-				err := s.storage.UpdateEventNotified(ctx, msg.ID)
-				if err != nil {
-					s.log.Errorf("Can't update notified:%v\n", err)
-					continue
-				}
-				s.log.Debugf("UpdateEventNotified: updated\n")
+				s.handle(ctx, d)
 			}
 		}
 	}
@@ -94,3 +120,122 @@ func (s Sender) Stop(ctx context.Context) {
 	s.storage.Close(ctx)
 	s.log.Debugf("Storage closed\n")
 }
+
+// handle settles a delivery once it is processed: a notification that was not delivered is retried,
+// a notification of a deleted event is dropped.
+func (s Sender) handle(ctx context.Context, d model.NotificationDelivery) {
+	err := s.Notify(ctx, d.Msg())
+	switch {
+	case storage.IsEventNotFound(err):
+		s.log.Warningf("Notification of a deleted event dropped:%v\n", err)
+		err = d.Ack()
+	case err != nil:
+		s.log.Errorf("Can't notify:%v\n", err)
+		err = d.Retry(err)
+	default:
+		s.log.Debugf("UpdateEventNotified: updated\n")
+		err = d.Ack()
+	}
+	if err != nil {
+		s.log.Errorf("Can't settle notification:%v\n", err)
+	}
+}
+
+// Notify renders msg by the templates of every channel in the locale of the user and delivers it.
+// A notification published again under the key of a delivered one is skipped, and so is every channel
+// that delivered it before a retry. The event is marked notified once every recipient got the notification.
+func (s Sender) Notify(ctx context.Context, msg model.NotificationMsg) error {
+	if msg.Key != "" {
+		delivered, err := s.storage.IsNotificationDelivered(ctx, msg.Key)
+		if err != nil {
+			return fmt.Errorf("can't check notification %v:%w", msg.Key, err)
+		}
+		if delivered {
+			s.log.Debugf("Notification %v already delivered\n", msg.Key)
+			return nil
+		}
+	}
+	user, err := s.storage.GetUser(ctx, msg.UserID)
+	if err != nil {
+		return fmt.Errorf("can't get user %v:%w", msg.UserID, err)
+	}
+	event, err := s.storage.GetEventByID(ctx, msg.ID)
+	if err != nil {
+		return fmt.Errorf("can't get event %v:%w", msg.ID, err)
+	}
+	if err := s.deliver(ctx, msg, notify.NewData(msg, event, user)); err != nil {
+		return err
+	}
+	if msg.Key == "" {
+		return s.storage.UpdateEventNotified(model.WithActor(ctx, actorSender), msg.ID)
+	}
+	if err := s.storage.MarkNotificationDelivered(ctx, msg.Key); err != nil {
+		return fmt.Errorf("can't mark notification %v delivered:%w", msg.Key, err)
+	}
+	return s.markNotified(ctx, msg, event)
+}
+
+// deliver sends msg through every channel it was not delivered through yet, it fails when a channel
+// failed or none reached the recipient.
+func (s Sender) deliver(ctx context.Context, msg model.NotificationMsg, data notify.Data) error {
+	var errs []error
+	delivered := 0
+	for _, channel := range s.channels {
+		key := model.ChannelKey(msg.Key, channel.Name())
+		if msg.Key != "" {
+			done, err := s.storage.IsNotificationDelivered(ctx, key)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%v:%w", channel.Name(), err))
+				continue
+			}
+			if done {
+				s.log.Debugf("Channel %v delivered notification %v before\n", channel.Name(), msg.Key)
+				delivered++
+				continue
+			}
+		}
+		n, err := s.templates.Render(channel.Name(), data)
+		if err == nil {
+			err = s.send(ctx, channel, &n)
+		}
+		if err == nil && msg.Key != "" {
+			err = s.storage.MarkNotificationDelivered(ctx, key)
+		}
+		switch {
+		case errors.Is(err, notify.ErrNoAddress):
+			s.log.Debugf("Channel %v skipped user:%v\n", channel.Name(), msg.UserID)
+		case err != nil:
+			errs = append(errs, fmt.Errorf("%v:%w", channel.Name(), err))
+		default:
+			delivered++
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%w(event %v user %v):%w", ErrNotDelivered, msg.ID, msg.UserID, errors.Join(errs...))
+	}
+	if delivered == 0 {
+		return fmt.Errorf("%w(event %v user %v has no address)", ErrNotDelivered, msg.ID, msg.UserID)
+	}
+	return nil
+}
+
+// markNotified marks the event of msg notified once the notification of the occurrence was delivered
+// to every recipient, the sender delivering the last one marks it.
+func (s Sender) markNotified(ctx context.Context, msg model.NotificationMsg, event model.Event) error {
+	for _, userID := range event.Recipients() {
+		delivered, err := s.storage.IsNotificationDelivered(ctx, model.RecipientKey(msg.Key, userID))
+		if err != nil {
+			return fmt.Errorf("can't check notification of user %v:%w", userID, err)
+		}
+		if !delivered {
+			return nil
+		}
+	}
+	return s.storage.UpdateEventNotified(model.WithActor(ctx, actorSender), msg.ID)
+}
+
+func (s Sender) send(ctx context.Context, channel notify.Channel, n *notify.Notification) error {
+	ctx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+	return channel.Send(ctx, n)
+}
@@ -0,0 +1,143 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/notify"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeChannel struct {
+	name string
+	err  error
+	sent []notify.Notification
+}
+
+// Name borrows the templates of the mail channel unless the channel is named.
+func (c *fakeChannel) Name() string {
+	if c.name != "" {
+		return c.name
+	}
+	return notify.ChannelSMTP
+}
+
+func (c *fakeChannel) Send(ctx context.Context, n *notify.Notification) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.sent = append(c.sent, *n)
+	return nil
+}
+
+type fakeDelivery struct {
+	msg     model.NotificationMsg
+	acked   bool
+	retried error
+}
+
+func (d *fakeDelivery) Msg() model.NotificationMsg {
+	return d.msg
+}
+
+func (d *fakeDelivery) Ack() error {
+	d.acked = true
+	return nil
+}
+
+func (d *fakeDelivery) Retry(reason error) error {
+	d.retried = reason
+	return nil
+}
+
+func TestSenderNotify(t *testing.T) {
+	f := newNotifyFixture(t)
+	ctx, db := f.ctx, f.db
+	calendar := &Calendar{log: f.log, storage: db}
+	require.ErrorIs(t, calendar.UpdateUser(ctx, &model.User{ID: 990, Email: "Bob <bob@example.com>"}), server.ErrEmail)
+	require.ErrorIs(t, calendar.UpdateUser(ctx, &model.User{ID: 990, Locale: "russian!"}), server.ErrLocale)
+	require.NoError(t, calendar.UpdateUser(ctx, &model.User{ID: 990, Email: "bob@example.com", Locale: "ru-RU"}))
+
+	e := f.dueEvent(t, 990, "notify")
+	msg := model.NotificationMsg{ID: e.ID, Title: e.Title, Date: e.OnTime, UserID: 990}
+
+	// a failed channel keeps the event to notify
+	delivered, failed := &fakeChannel{}, &fakeChannel{err: errors.New("down")}
+	sender := f.sender(delivered, failed)
+	require.ErrorIs(t, sender.Notify(ctx, msg), ErrNotDelivered)
+	require.False(t, f.notified(t, e.ID))
+
+	// a channel that can't reach the recipient is skipped
+	skipped := &fakeChannel{err: notify.ErrNoAddress}
+	sender.channels = []notify.Channel{skipped}
+	require.ErrorIs(t, sender.Notify(ctx, msg), ErrNotDelivered)
+	require.False(t, f.notified(t, e.ID))
+
+	sender.channels = []notify.Channel{delivered, skipped}
+	require.NoError(t, sender.Notify(ctx, msg))
+	require.True(t, f.notified(t, e.ID))
+	require.Len(t, delivered.sent, 2)
+	require.Equal(t, "bob@example.com", delivered.sent[1].Email)
+	require.Equal(t, "Напоминание: notify", delivered.sent[1].Subject)
+	require.NotEmpty(t, delivered.sent[1].HTML)
+}
+
+func TestSenderSettle(t *testing.T) {
+	f := newNotifyFixture(t)
+	ctx, db := f.ctx, f.db
+	e := f.dueEvent(t, 991, "settle")
+	msg := model.NotificationMsg{ID: e.ID, Title: e.Title, Date: e.OnTime, UserID: 991}
+
+	// an undelivered notification is retried, not acknowledged
+	failed := &fakeChannel{err: errors.New("down")}
+	sender := f.sender(failed)
+	d := &fakeDelivery{msg: msg}
+	sender.handle(ctx, d)
+	require.False(t, d.acked)
+	require.ErrorIs(t, d.retried, ErrNotDelivered)
+
+	sender.channels = []notify.Channel{&fakeChannel{}}
+	d = &fakeDelivery{msg: msg}
+	sender.handle(ctx, d)
+	require.True(t, d.acked)
+	require.NoError(t, d.retried)
+
+	// the notification of a deleted event is dropped
+	require.NoError(t, db.DeleteEvent(ctx, e.ID))
+	d = &fakeDelivery{msg: msg}
+	sender.handle(ctx, d)
+	require.True(t, d.acked)
+	require.NoError(t, d.retried)
+}
+
+func TestSenderRetryDelivered(t *testing.T) {
+	f := newNotifyFixture(t)
+	ctx, db := f.ctx, f.db
+	e := f.dueEvent(t, 994, "retry")
+	require.NoError(t, db.SetAttendee(ctx, e.ID, model.Attendee{UserID: 995, Status: model.PartStatAccepted}))
+	scheduler := f.scheduler(&recordingProducer{})
+	enqueued, err := scheduler.EnqueueNotifications(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), enqueued)
+	entries, err := db.GetOutbox(ctx, 0)
+	require.NoError(t, err)
+
+	// a retry only goes through the channel that failed
+	mail, hook := &fakeChannel{}, &fakeChannel{name: notify.ChannelWebhook, err: errors.New("down")}
+	sender := f.sender(mail, hook)
+	require.ErrorIs(t, sender.Notify(ctx, entries[0].Msg), ErrNotDelivered)
+	hook.err = nil
+	require.NoError(t, sender.Notify(ctx, entries[0].Msg))
+	require.Len(t, mail.sent, 1)
+	require.Len(t, hook.sent, 1)
+
+	// the event is notified once every recipient got the notification
+	require.False(t, f.notified(t, e.ID))
+	require.NoError(t, sender.Notify(ctx, entries[1].Msg))
+	require.True(t, f.notified(t, e.ID))
+	require.Len(t, mail.sent, 2)
+}
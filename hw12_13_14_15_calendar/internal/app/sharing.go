@@ -0,0 +1,261 @@
+package app
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/auth"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/storage"
+)
+
+// access returns the permission of the authenticated user on a calendar of an owner.
+// Calendar 0 is the personal calendar of the owner, it can't be shared.
+func (a *Calendar) access(ctx context.Context, ownerID, calendarID int64) (model.Permission, error) {
+	p, ok := auth.FromContext(ctx)
+	if !ok || p.IsAdmin() || p.UserID == ownerID {
+		return model.PermissionOwner, nil
+	}
+	if calendarID == 0 {
+		return "", nil
+	}
+	share, err := a.storage.GetCalendarShare(ctx, calendarID, p.UserID)
+	switch {
+	case storage.IsShareNotFound(err):
+		return "", nil
+	case err != nil:
+		return "", err
+	}
+	return share.Permission, nil
+}
+
+// authorizeCalendar checks that the authenticated user has at least the needed access to a calendar.
+func (a *Calendar) authorizeCalendar(ctx context.Context, ownerID, calendarID int64, need model.Permission,
+) (model.Permission, error) {
+	if calendarID == 0 {
+		return model.PermissionOwner, a.authorize(ctx, ownerID)
+	}
+	permission, err := a.access(ctx, ownerID, calendarID)
+	if err != nil {
+		return "", err
+	}
+	if !permission.Allows(need) {
+		p, _ := auth.FromContext(ctx)
+		return "", server.NewError(server.ErrPermission, "(user %v has no %v access to calendar %v)",
+			p.UserID, need, calendarID)
+	}
+	return permission, nil
+}
+
+// checkEventCalendar makes the owner of a calendar the owner of its events and checks the write access to it.
+func (a *Calendar) checkEventCalendar(ctx context.Context, event *model.Event) error {
+	if event.CalendarID == 0 {
+		return a.authorize(ctx, event.UserID)
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	c, err := a.storage.GetCalendar(ctx, event.CalendarID)
+	if err != nil {
+		return storageError(err)
+	}
+	if event.UserID == 0 {
+		event.UserID = c.OwnerID
+	}
+	if event.UserID != c.OwnerID {
+		return server.NewError(server.ErrUserID, "(calendar %v belongs to user %v)", c.ID, c.OwnerID)
+	}
+	_, err = a.authorizeCalendar(ctx, c.OwnerID, c.ID, model.PermissionWrite)
+	return err
+}
+
+func (a *Calendar) checkingCalendar(c *model.Calendar) error {
+	if c.OwnerID == 0 {
+		return server.NewError(server.ErrUserID, "(OwnerID is %v)", c.OwnerID)
+	}
+	if c.Name == "" || len(c.Name) > 150 {
+		return server.NewError(server.ErrCalendarName, "(len %v, must be 1..150)", len(c.Name))
+	}
+	if !c.ConflictPolicy.Valid() {
+		return server.NewError(server.ErrConflictPolicy,
+			"(%q, must be empty, reject, allow or allow-with-warning)", c.ConflictPolicy)
+	}
+	return nil
+}
+
+// ownCalendar loads a calendar and checks that the authenticated user may manage it.
+func (a *Calendar) ownCalendar(ctx context.Context, id int64) (model.Calendar, error) {
+	if id == 0 {
+		return model.Calendar{}, server.NewError(server.ErrCalendarNotFound, "(ID is zero)")
+	}
+	c, err := a.storage.GetCalendar(ctx, id)
+	if err != nil {
+		return model.Calendar{}, storageError(err)
+	}
+	if _, err := a.authorizeCalendar(ctx, c.OwnerID, c.ID, model.PermissionOwner); err != nil {
+		return model.Calendar{}, err
+	}
+	c.Permission = model.PermissionOwner
+	return c, nil
+}
+
+func (a *Calendar) CreateCalendar(ctx context.Context, c *model.Calendar) error {
+	if err := a.checkingCalendar(c); err != nil {
+		return err
+	}
+	if err := a.authorize(ctx, c.OwnerID); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	if err := a.storage.InsertCalendar(ctx, c); err != nil {
+		return err
+	}
+	c.Permission = model.PermissionOwner
+	return nil
+}
+
+// UpdateCalendar changes the name and description of a calendar, the owner can't be changed.
+func (a *Calendar) UpdateCalendar(ctx context.Context, c *model.Calendar) error {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	stored, err := a.ownCalendar(ctx, c.ID)
+	if err != nil {
+		return err
+	}
+	if c.OwnerID == 0 {
+		c.OwnerID = stored.OwnerID
+	}
+	if c.OwnerID != stored.OwnerID {
+		return server.NewError(server.ErrUserID, "(owner of a calendar can't be changed)")
+	}
+	if err := a.checkingCalendar(c); err != nil {
+		return err
+	}
+	c.Permission = model.PermissionOwner
+	return storageError(a.storage.UpdateCalendar(ctx, c))
+}
+
+// DeleteCalendar deletes a calendar with all its events and shares.
+func (a *Calendar) DeleteCalendar(ctx context.Context, id int64) error {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	if _, err := a.ownCalendar(ctx, id); err != nil {
+		return err
+	}
+	return storageError(a.storage.DeleteCalendar(ctx, id))
+}
+
+func (a *Calendar) GetCalendar(ctx context.Context, id int64) (model.Calendar, error) {
+	if id == 0 {
+		return model.Calendar{}, server.NewError(server.ErrCalendarNotFound, "(ID is zero)")
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	c, err := a.storage.GetCalendar(ctx, id)
+	if err != nil {
+		return model.Calendar{}, storageError(err)
+	}
+	if c.Permission, err = a.authorizeCalendar(ctx, c.OwnerID, c.ID, model.PermissionFreeBusy); err != nil {
+		return model.Calendar{}, err
+	}
+	return c, nil
+}
+
+// GetCalendars returns the calendars owned by a user and the calendars shared with them.
+func (a *Calendar) GetCalendars(ctx context.Context, userID int64) ([]model.Calendar, error) {
+	if userID == 0 {
+		return []model.Calendar{}, server.ErrUserID
+	}
+	if err := a.authorize(ctx, userID); err != nil {
+		return []model.Calendar{}, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	return a.storage.GetCalendars(ctx, userID)
+}
+
+// ShareCalendar grants or changes the access of a user to a calendar, only the owner can share it.
+func (a *Calendar) ShareCalendar(ctx context.Context, share *model.CalendarShare) error {
+	if share.UserID == 0 {
+		return server.ErrUserID
+	}
+	if !share.Permission.Valid() {
+		return server.NewError(server.ErrSharePermission, "(%q, must be freebusy, read or write)", share.Permission)
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	c, err := a.ownCalendar(ctx, share.CalendarID)
+	if err != nil {
+		return err
+	}
+	if share.UserID == c.OwnerID {
+		return server.NewError(server.ErrUserID, "(calendar can't be shared with its owner)")
+	}
+	return storageError(a.storage.UpsertCalendarShare(ctx, share))
+}
+
+// UnshareCalendar revokes the access of a user to a calendar. The owner can revoke any share,
+// a user can give up a calendar shared with them.
+func (a *Calendar) UnshareCalendar(ctx context.Context, calendarID, userID int64) error {
+	if userID == 0 {
+		return server.ErrUserID
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	if p, ok := auth.FromContext(ctx); !ok || p.UserID != userID {
+		if _, err := a.ownCalendar(ctx, calendarID); err != nil {
+			return err
+		}
+	}
+	return storageError(a.storage.DeleteCalendarShare(ctx, calendarID, userID))
+}
+
+func (a *Calendar) GetCalendarShares(ctx context.Context, calendarID int64) ([]model.CalendarShare, error) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	if _, err := a.ownCalendar(ctx, calendarID); err != nil {
+		return []model.CalendarShare{}, err
+	}
+	return a.storage.GetCalendarShares(ctx, calendarID)
+}
+
+// GetCalendarsEvents returns the events of [begin, end) of several calendars with times presented
+// in the zone of begin. Events of calendars shared for free/busy only carry their times.
+func (a *Calendar) GetCalendarsEvents(ctx context.Context, calendarIDs []int64, begin, end time.Time,
+) ([]model.Event, error) {
+	if len(calendarIDs) == 0 {
+		return []model.Event{}, server.NewError(server.ErrCalendarNotFound, "(no calendars)")
+	}
+	if !end.After(begin) {
+		return []model.Event{}, server.NewError(server.ErrOffTime, "(end of range is not after begin)")
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	events := []model.Event{}
+	seen := make(map[int64]bool, len(calendarIDs))
+	for _, id := range calendarIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		c, err := a.GetCalendar(ctx, id)
+		if err != nil {
+			return []model.Event{}, err
+		}
+		calendarEvents, err := a.storage.GetCalendarRange(ctx, id, begin, end)
+		if err != nil {
+			return []model.Event{}, err
+		}
+		for _, e := range calendarEvents {
+			if !c.Permission.Allows(model.PermissionRead) {
+				e = e.FreeBusy()
+			}
+			events = append(events, e.In(begin.Location()))
+		}
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].OnTime.Before(events[j].OnTime) })
+	return events, nil
+}
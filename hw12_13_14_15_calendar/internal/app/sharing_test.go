@@ -0,0 +1,90 @@
+package app
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/auth"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendarSharing(t *testing.T) {
+	api := newTestAPI(t,
+		auth.APIKeyConf{Key: "owner", UserID: 700},
+		auth.APIKeyConf{Key: "reader", UserID: 701},
+		auth.APIKeyConf{Key: "busy", UserID: 702},
+		auth.APIKeyConf{Key: "writer", UserID: 703},
+	)
+
+	res := api.doAs("owner", http.MethodPost, "/users/700/calendars", `{"name": "work"}`)
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	var work model.Calendar
+	api.decode(res, &work)
+	require.Equal(t, model.PermissionOwner, work.Permission)
+	path := fmt.Sprintf("/calendars/%d", work.ID)
+	require.Equal(t, path, res.Header.Get("Location"))
+
+	require.Equal(t, http.StatusForbidden,
+		api.doAs("reader", http.MethodPost, "/users/700/calendars", `{"name": "x"}`).StatusCode)
+	require.Equal(t, http.StatusUnprocessableEntity,
+		api.doAs("owner", http.MethodPost, "/users/700/calendars", `{"name": ""}`).StatusCode)
+
+	event := `{"title": "standup", "ontime": "2018-03-01T10:00:00Z", "offtime": "2018-03-01T11:00:00Z"}`
+	res = api.doAs("owner", http.MethodPost, path+"/events", event)
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	var standup model.Event
+	api.decode(res, &standup)
+	require.Equal(t, int64(700), standup.UserID)
+	require.Equal(t, work.ID, standup.CalendarID)
+
+	share := func(key string, userID int64, permission model.Permission) int {
+		body := fmt.Sprintf(`{"permission": %q}`, permission)
+		return api.doAs(key, http.MethodPut, fmt.Sprintf("%s/shares/%d", path, userID), body).StatusCode
+	}
+	rangeQuery := "/events?from=2018-03-01&to=2018-03-02"
+	require.Equal(t, http.StatusForbidden, api.doAs("reader", http.MethodGet, path+rangeQuery, "").StatusCode)
+	require.Equal(t, http.StatusOK, share("owner", 701, model.PermissionRead))
+	require.Equal(t, http.StatusOK, share("owner", 702, model.PermissionFreeBusy))
+	require.Equal(t, http.StatusOK, share("owner", 703, model.PermissionWrite))
+	require.Equal(t, http.StatusUnprocessableEntity, share("owner", 701, "admin"))
+	require.Equal(t, http.StatusForbidden, share("writer", 704, model.PermissionRead))
+
+	var shares []model.CalendarShare
+	api.decode(api.doAs("owner", http.MethodGet, path+"/shares", ""), &shares)
+	require.Len(t, shares, 3)
+
+	var calendars []model.Calendar
+	api.decode(api.doAs("reader", http.MethodGet, "/users/701/calendars", ""), &calendars)
+	require.Len(t, calendars, 1)
+	require.Equal(t, model.PermissionRead, calendars[0].Permission)
+
+	// readers see the event, free/busy viewers only its time
+	var events []model.Event
+	api.decode(api.doAs("reader", http.MethodGet, path+rangeQuery, ""), &events)
+	require.Len(t, events, 1)
+	require.Equal(t, "standup", events[0].Title)
+	events = nil
+	query := fmt.Sprintf("/events?calendars=%d&from=2018-03-01&to=2018-03-02", work.ID)
+	api.decode(api.doAs("busy", http.MethodGet, query, ""), &events)
+	require.Len(t, events, 1)
+	require.Empty(t, events[0].Title)
+	require.True(t, events[0].OnTime.Equal(standup.OnTime))
+
+	eventPath := fmt.Sprintf("/events/%d", standup.ID)
+	require.Equal(t, http.StatusForbidden, api.doAs("reader", http.MethodDelete, eventPath, "").StatusCode)
+	require.Equal(t, http.StatusForbidden, api.doAs("reader", http.MethodPost, path+"/events", event).StatusCode)
+	require.Equal(t, http.StatusOK, api.doAs("writer", http.MethodPatch, eventPath, `{"title": "retro"}`).StatusCode)
+	var changed model.Event
+	api.decode(api.doAs("reader", http.MethodGet, eventPath, ""), &changed)
+	require.Equal(t, "retro", changed.Title)
+
+	// a user can give up a share, the calendar and its events go away with the calendar
+	require.Equal(t, http.StatusNoContent, api.doAs("reader", http.MethodDelete, path+"/shares/701", "").StatusCode)
+	require.Equal(t, http.StatusForbidden, api.doAs("reader", http.MethodGet, path, "").StatusCode)
+	require.Equal(t, http.StatusForbidden, api.doAs("writer", http.MethodDelete, path, "").StatusCode)
+	require.Equal(t, http.StatusNoContent, api.doAs("owner", http.MethodDelete, path, "").StatusCode)
+	require.Equal(t, http.StatusNotFound, api.doAs("owner", http.MethodGet, eventPath, "").StatusCode)
+	require.Equal(t, http.StatusNotFound, api.doAs("owner", http.MethodGet, path, "").StatusCode)
+}
@@ -0,0 +1,132 @@
+package app
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/logger"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server"
+	memorystorage "github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/storage/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendarFreeBusy(t *testing.T) {
+	db := memorystorage.New()
+	log := logger.NewLogger("DEBUG", os.Stdout)
+	calendar := &Calendar{log: log, storage: db}
+	ctx := context.Background()
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2020, time.June, day, hour, minute, 0, 0, time.UTC)
+	}
+	insert := func(userID int64, onTime, offTime time.Time) *model.Event {
+		e := &model.Event{UserID: userID, Title: "busy", OnTime: onTime, OffTime: offTime, TimeZone: "UTC"}
+		require.NoError(t, calendar.InsertEvent(ctx, e))
+		return e
+	}
+
+	insert(900, at(1, 9, 0), at(1, 10, 0))
+	insert(900, at(1, 10, 15), at(1, 11, 0))
+	insert(901, at(1, 10, 30), at(1, 12, 0))
+	// an accepted invitation makes the attendee busy too
+	invite := insert(902, at(1, 13, 0), at(1, 14, 0))
+	require.NoError(t, calendar.InviteAttendee(ctx, invite.ID, 901))
+	require.NoError(t, calendar.RespondInvitation(ctx, invite.ID, 901, model.PartStatAccepted))
+
+	fb, err := calendar.GetFreeBusy(ctx, []int64{900, 901, 900}, at(1, 0, 0), at(2, 0, 0))
+	require.NoError(t, err)
+	require.Len(t, fb.Users, 2)
+	require.Equal(t, []model.Interval{
+		{Start: at(1, 9, 0), End: at(1, 10, 0)},
+		{Start: at(1, 10, 15), End: at(1, 11, 0)},
+	}, fb.Users[0].Busy)
+	require.Equal(t, []model.Interval{
+		{Start: at(1, 9, 0), End: at(1, 10, 0)},
+		{Start: at(1, 10, 15), End: at(1, 12, 0)},
+		{Start: at(1, 13, 0), End: at(1, 14, 0)},
+	}, fb.Busy)
+
+	slots, err := calendar.FindSlots(ctx, model.SlotQuery{
+		UserIDs: []int64{900, 901}, Minutes: 60, Begin: at(1, 0, 0), End: at(3, 0, 0),
+		WorkStart: "09:00", WorkEnd: "15:00", TimeZone: "UTC", Limit: 4,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []model.Interval{
+		{Start: at(1, 12, 0), End: at(1, 13, 0)},
+		{Start: at(1, 14, 0), End: at(1, 15, 0)},
+		{Start: at(2, 9, 0), End: at(2, 10, 0)},
+		{Start: at(2, 10, 0), End: at(2, 11, 0)},
+	}, slots)
+
+	// working hours are local to the requested zone
+	slots, err = calendar.FindSlots(ctx, model.SlotQuery{
+		UserIDs: []int64{900}, Minutes: 30, Begin: at(1, 0, 0), End: at(2, 0, 0),
+		TimeZone: "Europe/Berlin", Limit: 1,
+	})
+	require.NoError(t, err)
+	require.Len(t, slots, 1)
+	require.True(t, slots[0].Start.Equal(at(1, 7, 0)))
+
+	_, err = calendar.FindSlots(ctx, model.SlotQuery{UserIDs: []int64{900}, Begin: at(1, 0, 0), End: at(2, 0, 0)})
+	require.ErrorIs(t, err, server.ErrDuration)
+	_, err = calendar.FindSlots(ctx, model.SlotQuery{
+		UserIDs: []int64{900}, Minutes: 30, Begin: at(1, 0, 0), End: at(2, 0, 0), WorkStart: "18:00", WorkEnd: "09:00",
+	})
+	require.ErrorIs(t, err, server.ErrWorkingHours)
+	_, err = calendar.GetFreeBusy(ctx, nil, at(1, 0, 0), at(2, 0, 0))
+	require.ErrorIs(t, err, server.ErrUserID)
+}
+
+func TestCalendarConflictPolicy(t *testing.T) {
+	db := memorystorage.New()
+	log := logger.NewLogger("DEBUG", os.Stdout)
+	calendar := &Calendar{log: log, storage: db}
+	ctx := context.Background()
+	at := func(hour, minute int) time.Time {
+		return time.Date(2020, time.July, 1, hour, minute, 0, 0, time.UTC)
+	}
+	event := func(onTime, offTime time.Time, status model.EventStatus) *model.Event {
+		return &model.Event{UserID: 910, Title: "meeting", OnTime: onTime, OffTime: offTime, Status: status}
+	}
+
+	first := event(at(10, 0), at(11, 0), "")
+	require.NoError(t, calendar.InsertEvent(ctx, first))
+	// back-to-back events share an endpoint only
+	second := event(at(11, 0), at(12, 0), model.EventStatusConfirmed)
+	require.NoError(t, calendar.InsertEvent(ctx, second))
+	require.Empty(t, second.Conflicts)
+	// an event containing another one overlaps it
+	require.ErrorIs(t, calendar.InsertEvent(ctx, event(at(9, 0), at(13, 0), "")), server.ErrDateBusy)
+
+	// tentative events overlap with a warning
+	tentative := event(at(10, 30), at(11, 30), model.EventStatusTentative)
+	require.NoError(t, calendar.InsertEvent(ctx, tentative))
+	require.Len(t, tentative.Conflicts, 2)
+	require.Equal(t, first.ID, tentative.Conflicts[0].EventID)
+	stored, err := calendar.GetEventByID(ctx, tentative.ID)
+	require.NoError(t, err)
+	require.Empty(t, stored.Conflicts)
+
+	// confirming the tentative event is rejected while it overlaps
+	tentative.Status = model.EventStatusConfirmed
+	require.ErrorIs(t, calendar.UpdateEvent(ctx, tentative), server.ErrDateBusy)
+
+	require.NoError(t, calendar.UpdateUser(ctx, &model.User{ID: 910, ConflictPolicy: model.ConflictPolicyWarn}))
+	require.NoError(t, calendar.UpdateEvent(ctx, tentative))
+	require.Len(t, tentative.Conflicts, 2)
+
+	// the policy of a calendar wins over the policy of the user
+	c := &model.Calendar{OwnerID: 910, Name: "open", ConflictPolicy: model.ConflictPolicyAllow}
+	require.NoError(t, calendar.CreateCalendar(ctx, c))
+	open := event(at(10, 0), at(12, 0), "")
+	open.CalendarID = c.ID
+	require.NoError(t, calendar.InsertEvent(ctx, open))
+	require.Empty(t, open.Conflicts)
+
+	c.ConflictPolicy = "sometimes"
+	require.ErrorIs(t, calendar.UpdateCalendar(ctx, c), server.ErrConflictPolicy)
+	require.ErrorIs(t, calendar.UpdateUser(ctx, &model.User{ID: 910, ConflictPolicy: "never"}), server.ErrConflictPolicy)
+	require.ErrorIs(t, calendar.InsertEvent(ctx, event(at(15, 0), at(16, 0), "maybe")), server.ErrStatus)
+}
@@ -0,0 +1,52 @@
+package app
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/logger"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server"
+	memorystorage "github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/storage/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendarFeed(t *testing.T) {
+	ctx := context.Background()
+	db := memorystorage.New()
+	calendar := &Calendar{log: logger.NewLogger("DEBUG", os.Stdout), storage: db}
+
+	onTime := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	event := &model.Event{UserID: 3, Title: "review", OnTime: onTime, OffTime: onTime.Add(time.Hour)}
+	require.NoError(t, calendar.InsertEvent(ctx, event))
+
+	token, err := calendar.CreateFeedToken(ctx, 3)
+	require.NoError(t, err)
+	require.NotEmpty(t, token.Token)
+
+	feed, err := calendar.GetFeed(ctx, token.Token)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), feed.UserID)
+	require.NotEmpty(t, feed.ETag)
+	require.True(t, strings.Contains(string(feed.Data), "SUMMARY:review"))
+
+	again, err := calendar.GetFeed(ctx, token.Token)
+	require.NoError(t, err)
+	require.Equal(t, feed.ETag, again.ETag)
+	require.Equal(t, feed.Modified, again.Modified)
+	require.Equal(t, feed.Data, again.Data)
+
+	event.Title = "review v2"
+	require.NoError(t, calendar.UpdateEvent(ctx, event))
+	changed, err := calendar.GetFeed(ctx, token.Token)
+	require.NoError(t, err)
+	require.NotEqual(t, feed.ETag, changed.ETag)
+
+	require.ErrorIs(t, calendar.RevokeFeedToken(ctx, 4, token.Token), server.ErrFeedToken)
+	require.NoError(t, calendar.RevokeFeedToken(ctx, 3, token.Token))
+	_, err = calendar.GetFeed(ctx, token.Token)
+	require.ErrorIs(t, err, server.ErrFeedToken)
+}
@@ -0,0 +1,66 @@
+package app
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/logger"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	memorystorage "github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/storage/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendarTimeZones(t *testing.T) {
+	ctx := context.Background()
+	db := memorystorage.New()
+	calendar := &Calendar{log: logger.NewLogger("DEBUG", os.Stdout), storage: db}
+
+	// 2024-03-11 01:00 in Tokyo, 2024-03-10 17:00 in Berlin
+	onTime := time.Date(2024, 3, 10, 16, 0, 0, 0, time.UTC)
+	event := &model.Event{UserID: 1, Title: "call", OnTime: onTime, OffTime: onTime.Add(30 * time.Minute)}
+	require.NoError(t, calendar.InsertEvent(ctx, event))
+
+	date := time.Date(2024, 3, 11, 12, 0, 0, 0, time.UTC)
+	events, err := calendar.GetAllEventsDay(ctx, 1, date, "Asia/Tokyo")
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "Asia/Tokyo", events[0].OnTime.Location().String())
+
+	events, err = calendar.GetAllEventsDay(ctx, 1, date, "Europe/Berlin")
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	require.NoError(t, calendar.UpdateUser(ctx, &model.User{ID: 1, TimeZone: "Asia/Tokyo"}))
+	events, err = calendar.GetAllEventsDay(ctx, 1, date, "")
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	_, err = calendar.GetAllEventsDay(ctx, 1, date, "Mars/Olympus")
+	require.Error(t, err)
+}
+
+func TestCalendarDSTBoundaries(t *testing.T) {
+	ctx := context.Background()
+	db := memorystorage.New()
+	calendar := &Calendar{log: logger.NewLogger("DEBUG", os.Stdout), storage: db}
+
+	// 2024-03-10 lasts 23 hours in New York, 00:30 EDT of the next day is 04:30 UTC
+	onTime := time.Date(2024, 3, 11, 4, 30, 0, 0, time.UTC)
+	event := &model.Event{UserID: 1, Title: "late", OnTime: onTime, OffTime: onTime.Add(10 * time.Minute)}
+	require.NoError(t, calendar.InsertEvent(ctx, event))
+
+	date := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	events, err := calendar.GetAllEventsDay(ctx, 1, date, "America/New_York")
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	events, err = calendar.GetAllEventsWeek(ctx, 1, date, "America/New_York")
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	events, err = calendar.GetAllEventsWeek(ctx, 1, date.AddDate(0, 0, 1), "America/New_York")
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+}
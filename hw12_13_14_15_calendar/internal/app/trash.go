@@ -0,0 +1,59 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server"
+)
+
+// GetTrash returns the deleted events of a user that can still be restored, the latest deleted first.
+func (a *Calendar) GetTrash(ctx context.Context, userID int64) ([]model.Event, error) {
+	if userID == 0 {
+		return []model.Event{}, server.ErrUserID
+	}
+	if err := a.authorize(ctx, userID); err != nil {
+		return []model.Event{}, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	return a.storage.GetTrash(ctx, userID)
+}
+
+// RestoreEvent moves an event back from the trash of its owner. The restored event is checked
+// for conflicts like a new one, the allowed overlaps are returned with it.
+func (a *Calendar) RestoreEvent(ctx context.Context, userID, id int64) (model.Event, error) {
+	trash, err := a.GetTrash(ctx, userID)
+	if err != nil {
+		return model.Event{}, err
+	}
+	var event model.Event
+	for i := range trash {
+		if trash[i].ID == id {
+			event = trash[i]
+		}
+	}
+	if event.ID == 0 {
+		return model.Event{}, server.NewError(server.ErrEventNotFound, "(event %v is not in the trash of user %v)",
+			id, userID)
+	}
+
+	event.DeletedAt = time.Time{}
+	conflicts, err := a.checkConflicts(ctx, &event)
+	if err != nil {
+		return model.Event{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	if err := a.storage.RestoreEvent(ctx, id); err != nil {
+		return model.Event{}, storageError(err)
+	}
+	restored, err := a.storage.GetEventByID(ctx, id)
+	if err != nil {
+		return model.Event{}, storageError(err)
+	}
+	restored.Conflicts = conflicts
+	return restored, nil
+}
@@ -0,0 +1,527 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/auth"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/logger"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	internalgrpc "github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server/grpc"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server/http"
+	memorystorage "github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/storage/memory"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/pkg/event_service_v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// testAPI is the REST API of a calendar on a memory storage served for a test.
+type testAPI struct {
+	t        *testing.T
+	db       *memorystorage.Storage
+	log      *logger.Logger
+	calendar *Calendar
+	ts       *httptest.Server
+}
+
+// newTestAPI serves the REST API, the requests must carry one of the API keys when some are given.
+func newTestAPI(t *testing.T, keys ...auth.APIKeyConf) *testAPI {
+	t.Helper()
+	db := memorystorage.New()
+	log := logger.NewLogger("DEBUG", os.Stdout)
+	calendar := &Calendar{log: log, storage: db}
+	httpsrv := internalhttp.NewServer(log, calendar, "", "")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/users/", httpsrv.Users)
+	mux.HandleFunc("/events/", httpsrv.Events)
+	mux.HandleFunc("/events", httpsrv.CalendarsEvents)
+	mux.HandleFunc("/calendars/", httpsrv.Calendars)
+	var handler http.Handler = mux
+	if len(keys) > 0 {
+		authenticator, err := auth.New(auth.Conf{Enabled: true, APIKeys: keys})
+		require.NoError(t, err)
+		httpsrv.SetAuthenticator(authenticator)
+		handler = httpsrv.AuthMiddleware(mux)
+	}
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+	return &testAPI{t: t, db: db, log: log, calendar: calendar, ts: ts}
+}
+
+// do sends a request with the headers given as name and value pairs, empty values are left out.
+// The response body is closed when the test ends.
+func (a *testAPI) do(method, path, body string, header ...string) *http.Response {
+	a.t.Helper()
+	req, err := http.NewRequestWithContext(context.Background(), method, a.ts.URL+path, strings.NewReader(body))
+	require.NoError(a.t, err)
+	for i := 0; i+1 < len(header); i += 2 {
+		if header[i+1] != "" {
+			req.Header.Set(header[i], header[i+1])
+		}
+	}
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(a.t, err)
+	a.t.Cleanup(func() { res.Body.Close() })
+	return res
+}
+
+// doAs sends a request with the API key.
+func (a *testAPI) doAs(key, method, path, body string) *http.Response {
+	a.t.Helper()
+	return a.do(method, path, body, "X-API-Key", key)
+}
+
+func (a *testAPI) decode(res *http.Response, v interface{}) {
+	a.t.Helper()
+	data, err := io.ReadAll(res.Body)
+	require.NoError(a.t, err)
+	require.NoError(a.t, json.Unmarshal(data, v))
+}
+
+func TestCalendarRESTServer(t *testing.T) {
+	api := newTestAPI(t)
+
+	event := `{"title": "rest", "ontime": "2016-01-10T10:00:00Z", "offtime": "2016-01-10T11:00:00Z"}`
+	res := api.do(http.MethodPost, "/users/500/events", event)
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	var created model.Event
+	api.decode(res, &created)
+	require.Equal(t, int64(500), created.UserID)
+	location := res.Header.Get("Location")
+	require.Equal(t, fmt.Sprintf("/events/%d", created.ID), location)
+
+	res = api.do(http.MethodPost, "/users/500/events", event)
+	require.Equal(t, http.StatusConflict, res.StatusCode)
+
+	res = api.do(http.MethodPost, "/users/500/events", `{"title": "bad", "ontime": "2016-01-11T10:00:00Z"}`)
+	require.Equal(t, http.StatusUnprocessableEntity, res.StatusCode)
+	var body map[string]string
+	api.decode(res, &body)
+	require.Equal(t, map[string]string{
+		"error": "wrong OffTime: empty",
+		"code":  "invalid_argument",
+		"field": "offtime",
+	}, body)
+
+	// an override ending before its start is rejected, one moving only the start keeps the length
+	series := `{"title": "series", "ontime": "2016-01-04T10:00:00Z", "offtime": "2016-01-04T11:00:00Z",
+		"rrule": "FREQ=DAILY;COUNT=3", "overrides": [%s]}`
+	res = api.do(http.MethodPost, "/users/502/events", fmt.Sprintf(series,
+		`{"recurrenceid": "2016-01-05T10:00:00Z", "offtime": "2016-01-05T09:00:00Z"}`))
+	require.Equal(t, http.StatusUnprocessableEntity, res.StatusCode)
+	res = api.do(http.MethodPost, "/users/502/events", fmt.Sprintf(series,
+		`{"recurrenceid": "2016-01-05T10:00:00Z", "ontime": "2016-01-05T15:00:00Z"}`))
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	var occurrences []model.Event
+	api.decode(api.do(http.MethodGet, "/users/502/events?from=2016-01-05&to=2016-01-06", ""), &occurrences)
+	require.Len(t, occurrences, 1)
+	require.Equal(t, "2016-01-05T16:00:00Z", occurrences[0].OffTime.Format(time.RFC3339))
+
+	res = api.do(http.MethodGet, location, "")
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	res = api.do(http.MethodPatch, location, `{"title": "patched"}`)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	var patched model.Event
+	api.decode(res, &patched)
+	require.Equal(t, "patched", patched.Title)
+	require.True(t, created.OnTime.Equal(patched.OnTime))
+
+	res = api.do(http.MethodPut, location, `{"title": "put", "ontime": "2016-01-10T10:00:00Z"}`)
+	require.Equal(t, http.StatusUnprocessableEntity, res.StatusCode)
+	res = api.do(http.MethodPut, location, `{"userid": 501, "title": "put"}`)
+	require.Equal(t, http.StatusUnprocessableEntity, res.StatusCode)
+	var owner map[string]string
+	api.decode(res, &owner)
+	require.Equal(t, "invalid_argument", owner["code"])
+	require.Equal(t, "userid", owner["field"])
+
+	res = api.do(http.MethodGet, "/users/500/events?from=2016-01-10&to=2016-01-11", "")
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	var events []model.Event
+	api.decode(res, &events)
+	require.Len(t, events, 1)
+
+	res = api.do(http.MethodGet, "/users/500/events?period=week&date=2016-02-10", "")
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	api.decode(res, &events)
+	require.Empty(t, events)
+
+	// an event ending at midnight is not in the next day
+	res = api.do(http.MethodPost, "/users/500/events",
+		`{"title": "late", "ontime": "2016-01-12T23:00:00Z", "offtime": "2016-01-13T00:00:00Z"}`)
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	api.decode(api.do(http.MethodGet, "/users/500/events?period=day&date=2016-01-13&timezone=UTC", ""), &events)
+	require.Empty(t, events)
+	api.decode(api.do(http.MethodGet, "/users/500/events?period=day&date=2016-01-12&timezone=UTC", ""), &events)
+	require.Len(t, events, 1)
+
+	res = api.do(http.MethodGet, "/users/500/events?period=year", "")
+	require.Equal(t, http.StatusUnprocessableEntity, res.StatusCode)
+
+	res = api.do(http.MethodDelete, location, "")
+	require.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	res = api.do(http.MethodGet, location, "")
+	require.Equal(t, http.StatusNotFound, res.StatusCode)
+	res = api.do(http.MethodPut, location, event)
+	require.Equal(t, http.StatusNotFound, res.StatusCode)
+	res = api.do(http.MethodDelete, location, "")
+	require.Equal(t, http.StatusNotFound, res.StatusCode)
+
+	res = api.do(http.MethodPost, location, "")
+	require.Equal(t, http.StatusMethodNotAllowed, res.StatusCode)
+}
+
+func TestCalendarGRPCErrors(t *testing.T) {
+	db := memorystorage.New()
+	calendar := &Calendar{log: logger.NewLogger("DEBUG", os.Stdout), storage: db}
+
+	err := internalgrpc.StatusError(calendar.InsertEvent(context.Background(), &model.Event{UserID: 1}))
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 2)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	require.Equal(t, "invalid_argument", info.Reason)
+	require.Equal(t, "ontime", info.Metadata["field"])
+
+	_, err = calendar.GetEventByID(context.Background(), 100500)
+	st, _ = status.FromError(internalgrpc.StatusError(err))
+	require.Equal(t, codes.NotFound, st.Code())
+
+	// the details of an internal error stay on the server
+	st, _ = status.FromError(internalgrpc.StatusError(errors.New("pq: connection refused")))
+	require.Equal(t, codes.Internal, st.Code())
+	require.Equal(t, "internal error", st.Message())
+}
+
+func TestCalendarAuthorization(t *testing.T) {
+	api := newTestAPI(t,
+		auth.APIKeyConf{Key: "owner", UserID: 600},
+		auth.APIKeyConf{Key: "other", UserID: 601},
+		auth.APIKeyConf{Key: "root", UserID: 1, Roles: []string{auth.RoleAdmin}},
+	)
+
+	event := `{"title": "private", "ontime": "2017-01-10T10:00:00Z", "offtime": "2017-01-10T11:00:00Z"}`
+	require.Equal(t, http.StatusUnauthorized, api.doAs("", http.MethodPost, "/users/600/events", event).StatusCode)
+	require.Equal(t, http.StatusUnauthorized, api.doAs("bad", http.MethodPost, "/users/600/events", event).StatusCode)
+	require.Equal(t, http.StatusForbidden, api.doAs("other", http.MethodPost, "/users/600/events", event).StatusCode)
+
+	res := api.doAs("owner", http.MethodPost, "/users/600/events", event)
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	location := res.Header.Get("Location")
+
+	require.Equal(t, http.StatusForbidden, api.doAs("other", http.MethodGet, location, "").StatusCode)
+	require.Equal(t, http.StatusForbidden, api.doAs("other", http.MethodDelete, location, "").StatusCode)
+	require.Equal(t, http.StatusForbidden, api.doAs("other", http.MethodGet, "/users/600/events", "").StatusCode)
+	require.Equal(t, http.StatusOK, api.doAs("root", http.MethodGet, location, "").StatusCode)
+	require.Equal(t, http.StatusNoContent, api.doAs("owner", http.MethodDelete, location, "").StatusCode)
+}
+
+func TestCalendarInvitations(t *testing.T) {
+	api := newTestAPI(t,
+		auth.APIKeyConf{Key: "organizer", UserID: 800},
+		auth.APIKeyConf{Key: "guest", UserID: 801},
+		auth.APIKeyConf{Key: "stranger", UserID: 802},
+	)
+
+	event := `{"title": "review", "ontime": "2019-05-06T10:00:00Z", "offtime": "2019-05-06T11:00:00Z",
+		"notifytime": "2019-05-06T09:00:00Z", "attendees": [{"userid": 801, "status": "accepted"}]}`
+	res := api.doAs("organizer", http.MethodPost, "/users/800/events", event)
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	var created model.Event
+	api.decode(res, &created)
+	require.Equal(t, []model.Attendee{{UserID: 801, Status: model.PartStatNeedsAction}}, created.Attendees)
+	eventPath := fmt.Sprintf("/events/%d", created.ID)
+	invitation := fmt.Sprintf("/invitations/%d", created.ID)
+
+	require.Equal(t, http.StatusForbidden, api.doAs("guest", http.MethodPut, eventPath+"/attendees/802", "").StatusCode)
+	require.Equal(t, http.StatusNoContent,
+		api.doAs("organizer", http.MethodPut, eventPath+"/attendees/802", "").StatusCode)
+	require.Equal(t, http.StatusForbidden, api.doAs("stranger", http.MethodGet, "/users/801/invitations", "").StatusCode)
+
+	var invitations []model.Invitation
+	api.decode(api.doAs("guest", http.MethodGet, "/users/801/invitations", ""), &invitations)
+	require.Len(t, invitations, 1)
+	require.Equal(t, model.PartStatNeedsAction, invitations[0].Status)
+	require.Equal(t, http.StatusOK, api.doAs("guest", http.MethodGet, eventPath, "").StatusCode)
+
+	// accepted events show up in the range queries of the attendee
+	dayQuery := "/users/801/events?period=day&date=2019-05-06&timezone=UTC"
+	var events []model.Event
+	api.decode(api.doAs("guest", http.MethodGet, dayQuery, ""), &events)
+	require.Empty(t, events)
+	require.Equal(t, http.StatusUnprocessableEntity,
+		api.doAs("guest", http.MethodPut, "/users/801"+invitation, `{"status": "maybe"}`).StatusCode)
+	require.Equal(t, http.StatusForbidden,
+		api.doAs("stranger", http.MethodPut, "/users/801"+invitation, `{"status": "accepted"}`).StatusCode)
+	require.Equal(t, http.StatusNoContent,
+		api.doAs("guest", http.MethodPut, "/users/801"+invitation, `{"status": "accepted"}`).StatusCode)
+	require.Equal(t, http.StatusNoContent,
+		api.doAs("stranger", http.MethodPut, "/users/802"+invitation, `{"status": "declined"}`).StatusCode)
+	api.decode(api.doAs("guest", http.MethodGet, dayQuery, ""), &events)
+	require.Len(t, events, 1)
+	require.Equal(t, "review", events[0].Title)
+
+	// notifications go to the owner and to the attendees who accepted
+	producer := &recordingProducer{}
+	scheduler := Scheduler{log: api.log, storage: api.db, producer: producer}
+	enqueued, err := scheduler.EnqueueNotifications(context.Background(), time.Date(2019, 5, 6, 9, 30, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), enqueued)
+	sent, err := scheduler.RelayOutbox(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), sent)
+	require.ElementsMatch(t, []int64{800, 801}, producer.userIDs)
+
+	// an enqueued notification is not due anymore, a relayed one is not published again
+	enqueued, err = scheduler.EnqueueNotifications(context.Background(), time.Date(2019, 5, 6, 9, 40, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Zero(t, enqueued)
+	sent, err = scheduler.RelayOutbox(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, sent)
+
+	require.Equal(t, http.StatusNoContent, api.doAs("guest", http.MethodDelete, eventPath+"/attendees/801", "").StatusCode)
+	require.Equal(t, http.StatusNotFound, api.doAs("guest", http.MethodDelete, eventPath+"/attendees/801", "").StatusCode)
+	var attendees []model.Attendee
+	api.decode(api.doAs("organizer", http.MethodGet, eventPath+"/attendees", ""), &attendees)
+	require.Equal(t, []model.Attendee{{UserID: 802, Status: model.PartStatDeclined}}, attendees)
+}
+
+func TestCalendarEventVersions(t *testing.T) {
+	api := newTestAPI(t)
+
+	res := api.do(http.MethodPost, "/users/900/events",
+		`{"title": "versioned", "ontime": "2016-03-10T10:00:00Z", "offtime": "2016-03-10T11:00:00Z"}`)
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	require.Equal(t, `"1"`, res.Header.Get("ETag"))
+	location := res.Header.Get("Location")
+
+	res = api.do(http.MethodGet, location, "")
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	etag := res.Header.Get("ETag")
+	require.Equal(t, `"1"`, etag)
+
+	res = api.do(http.MethodPatch, location, `{"title": "first"}`, "If-Match", etag)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, `"2"`, res.Header.Get("ETag"))
+
+	// a second client still holding the first version is rejected
+	res = api.do(http.MethodPatch, location, `{"title": "second"}`, "If-Match", etag)
+	require.Equal(t, http.StatusPreconditionFailed, res.StatusCode)
+	var body map[string]string
+	api.decode(res, &body)
+	require.Equal(t, "failed_precondition", body["code"])
+	require.Equal(t, "version", body["field"])
+
+	// the version of a PUT body is checked as well
+	res = api.do(http.MethodPut, location,
+		`{"title": "put", "version": 1, "ontime": "2016-03-10T10:00:00Z", "offtime": "2016-03-10T11:00:00Z"}`)
+	require.Equal(t, http.StatusPreconditionFailed, res.StatusCode)
+
+	res = api.do(http.MethodPatch, location, `{"title": "forced"}`, "If-Match", "*")
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, `"3"`, res.Header.Get("ETag"))
+
+	res = api.do(http.MethodPatch, location, `{"title": "weak"}`, "If-Match", `W/"3"`)
+	require.Equal(t, http.StatusPreconditionFailed, res.StatusCode)
+
+	// a header that is not an event version is malformed rather than a conflict
+	for _, value := range []string{"3", `"abc"`, `"0"`, `"3`} {
+		res = api.do(http.MethodPatch, location, `{"title": "malformed"}`, "If-Match", value)
+		require.Equal(t, http.StatusBadRequest, res.StatusCode, value)
+		api.decode(res, &body)
+		require.Equal(t, "invalid_argument", body["code"], value)
+	}
+
+	var event model.Event
+	api.decode(api.do(http.MethodGet, location, ""), &event)
+	require.Equal(t, "forced", event.Title)
+	require.Equal(t, int64(3), event.Version)
+
+	// the version of a new event includes its attendees
+	res = api.do(http.MethodPost, "/users/900/events", `{"title": "invited", "ontime": "2016-03-11T10:00:00Z",
+		"offtime": "2016-03-11T11:00:00Z", "attendees": [{"userid": 901}]}`)
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	etag = res.Header.Get("ETag")
+	require.Equal(t, `"1"`, etag)
+	res = api.do(http.MethodPatch, res.Header.Get("Location"), `{"title": "renamed"}`, "If-Match", etag)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func TestCalendarEventPatch(t *testing.T) {
+	api := newTestAPI(t)
+	grpcsrv, _ := internalgrpc.NewServer(api.log, api.calendar, "", "")
+	get := func(location string) model.Event {
+		res := api.do(http.MethodGet, location, "")
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		var event model.Event
+		api.decode(res, &event)
+		return event
+	}
+
+	res := api.do(http.MethodPost, "/users/950/events", `{"title": "merge", "description": "kept",
+		"ontime": "2016-04-10T10:00:00Z", "offtime": "2016-04-10T11:00:00Z", "rrule": "FREQ=DAILY;COUNT=3"}`)
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	location := res.Header.Get("Location")
+
+	res = api.do(http.MethodPatch, location, `{"title": "merged", "rrule": null}`,
+		"Content-Type", "application/merge-patch+json")
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	event := get(location)
+	require.Equal(t, "merged", event.Title)
+	require.Equal(t, "kept", event.Description)
+	require.Empty(t, event.RRule)
+
+	// the merged event is validated as a whole
+	res = api.do(http.MethodPatch, location, `{"offtime": "2016-04-10T09:00:00Z"}`)
+	require.Equal(t, http.StatusUnprocessableEntity, res.StatusCode)
+	res = api.do(http.MethodPatch, location, `{"unknown": 1}`)
+	require.Equal(t, http.StatusBadRequest, res.StatusCode)
+	res = api.do(http.MethodPatch, location, `["title"]`)
+	require.Equal(t, http.StatusBadRequest, res.StatusCode)
+	res = api.do(http.MethodPatch, location, `{"title": "plain"}`, "Content-Type", "text/plain")
+	require.Equal(t, http.StatusUnsupportedMediaType, res.StatusCode)
+
+	ctx := context.Background()
+	title, description := "masked", "ignored"
+	_, err := grpcsrv.PatchEvent(ctx, &event_service_v1.ReqPatchEvent{
+		Event: &event_service_v1.Event{ID: &event.ID, UserID: &event.UserID, Title: &title, Description: &description},
+		Mask:  &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+	})
+	require.NoError(t, err)
+	event = get(location)
+	require.Equal(t, "masked", event.Title)
+	require.Equal(t, "kept", event.Description)
+
+	// without a mask the fields present in the event are changed
+	_, err = grpcsrv.PatchEvent(ctx, &event_service_v1.ReqPatchEvent{
+		Event: &event_service_v1.Event{ID: &event.ID, UserID: &event.UserID, Description: &description},
+	})
+	require.NoError(t, err)
+	event = get(location)
+	require.Equal(t, "masked", event.Title)
+	require.Equal(t, "ignored", event.Description)
+
+	_, err = grpcsrv.PatchEvent(ctx, &event_service_v1.ReqPatchEvent{
+		Event: &event_service_v1.Event{ID: &event.ID, UserID: &event.UserID},
+		Mask:  &fieldmaskpb.FieldMask{Paths: []string{"attendees"}},
+	})
+	st, _ := status.FromError(internalgrpc.StatusError(err))
+	require.Equal(t, codes.InvalidArgument, st.Code())
+
+	// an incomplete event is rejected instead of panicking
+	_, err = grpcsrv.UpdateEvent(ctx, &event_service_v1.ReqByEvent{
+		Event: &event_service_v1.Event{ID: &event.ID, UserID: &event.UserID},
+	})
+	st, _ = status.FromError(internalgrpc.StatusError(err))
+	require.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestCalendarEventHistory(t *testing.T) {
+	api := newTestAPI(t)
+
+	res := api.do(http.MethodPost, "/users/960/events", `{"title": "meeting",
+		"ontime": "2016-05-10T10:00:00Z", "offtime": "2016-05-10T11:00:00Z"}`)
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	location := res.Header.Get("Location")
+	res = api.do(http.MethodPatch, location, `{"ontime": "2016-05-10T12:00:00Z", "offtime": "2016-05-10T13:00:00Z"}`)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	res = api.do(http.MethodDelete, location, "")
+	require.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	// the history outlives the event
+	res = api.do(http.MethodGet, location+"/history", "")
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	var history []model.AuditEntry
+	api.decode(res, &history)
+	require.Len(t, history, 3)
+	moved := history[1]
+	require.Equal(t, model.AuditUpdate, moved.Action)
+	require.Equal(t, model.ActorAnonymous, moved.Actor)
+	require.Equal(t, "2016-05-10T10:00:00Z", moved.Before.OnTime.Format(time.RFC3339))
+	require.Equal(t, "2016-05-10T12:00:00Z", moved.After.OnTime.Format(time.RFC3339))
+	require.Equal(t, model.AuditDelete, history[2].Action)
+
+	res = api.do(http.MethodGet, "/events/100500/history", "")
+	require.Equal(t, http.StatusNotFound, res.StatusCode)
+	res = api.do(http.MethodPost, location+"/history", "")
+	require.Equal(t, http.StatusMethodNotAllowed, res.StatusCode)
+}
+
+func TestCalendarTrash(t *testing.T) {
+	api := newTestAPI(t,
+		auth.APIKeyConf{Key: "owner", UserID: 970},
+		auth.APIKeyConf{Key: "stranger", UserID: 971},
+	)
+
+	event := `{"title": "trashed", "ontime": "2016-06-10T10:00:00Z", "offtime": "2016-06-10T11:00:00Z"}`
+	res := api.doAs("owner", http.MethodPost, "/users/970/events", event)
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	location := res.Header.Get("Location")
+	var created model.Event
+	api.decode(res, &created)
+	restorePath := fmt.Sprintf("/users/970/trash/%d", created.ID)
+
+	require.Equal(t, http.StatusNoContent, api.doAs("owner", http.MethodDelete, location, "").StatusCode)
+	require.Equal(t, http.StatusNotFound, api.doAs("owner", http.MethodGet, location, "").StatusCode)
+	// the slot of a trashed event is free
+	require.Equal(t, http.StatusCreated, api.doAs("owner", http.MethodPost, "/users/970/events", event).StatusCode)
+
+	var trash []model.Event
+	api.decode(api.doAs("owner", http.MethodGet, "/users/970/trash", ""), &trash)
+	require.Len(t, trash, 1)
+	require.Equal(t, created.ID, trash[0].ID)
+	require.False(t, trash[0].DeletedAt.IsZero())
+	require.Equal(t, http.StatusForbidden, api.doAs("stranger", http.MethodGet, "/users/970/trash", "").StatusCode)
+	require.Equal(t, http.StatusForbidden, api.doAs("stranger", http.MethodPost, restorePath, "").StatusCode)
+	require.Equal(t, http.StatusNotFound,
+		api.doAs("stranger", http.MethodPost, fmt.Sprintf("/users/971/trash/%d", created.ID), "").StatusCode)
+
+	// the restored event overlaps the new one
+	require.Equal(t, http.StatusConflict, api.doAs("owner", http.MethodPost, restorePath, "").StatusCode)
+	user := model.User{ID: 970, ConflictPolicy: model.ConflictPolicyWarn}
+	require.NoError(t, api.calendar.UpdateUser(context.Background(), &user))
+	res = api.doAs("owner", http.MethodPost, restorePath, "")
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	var restored model.Event
+	api.decode(res, &restored)
+	require.Equal(t, "trashed", restored.Title)
+	require.Len(t, restored.Conflicts, 1)
+	require.Equal(t, http.StatusOK, api.doAs("owner", http.MethodGet, location, "").StatusCode)
+	require.Equal(t, http.StatusNotFound, api.doAs("owner", http.MethodPost, restorePath, "").StatusCode)
+
+	// the scheduler purges the trash after the retention
+	require.Equal(t, http.StatusNoContent, api.doAs("owner", http.MethodDelete, location, "").StatusCode)
+	scheduler := Scheduler{log: api.log, storage: api.db, conf: SchedulerConf{TrashRetention: time.Hour}}
+	purged, err := scheduler.PurgeTrash(context.Background(), time.Now())
+	require.NoError(t, err)
+	require.Zero(t, purged)
+	purged, err = scheduler.PurgeTrash(context.Background(), time.Now().Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), purged)
+	api.decode(api.doAs("owner", http.MethodGet, "/users/970/trash", ""), &trash)
+	require.Empty(t, trash)
+
+	var history []model.AuditEntry
+	api.decode(api.doAs("owner", http.MethodGet, location+"/history", ""), &history)
+	require.Equal(t, model.AuditPurge, history[len(history)-1].Action)
+	require.Equal(t, "scheduler", history[len(history)-1].Actor)
+	require.Equal(t, "user:970", history[0].Actor)
+}
@@ -0,0 +1,115 @@
+// Package auth authenticates API clients by static API keys or JWT and carries the principal in the context.
+package auth
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+)
+
+const RoleAdmin = "admin"
+
+var (
+	ErrNoCredentials = errors.New("no credentials")
+	ErrCredentials   = errors.New("invalid credentials")
+)
+
+type Conf struct {
+	Enabled bool         `toml:"enabled"`
+	APIKeys []APIKeyConf `toml:"api-keys"`
+	JWT     JWTConf      `toml:"jwt"`
+}
+
+type APIKeyConf struct {
+	Key    string   `toml:"key"`
+	UserID int64    `toml:"userid"`
+	Roles  []string `toml:"roles"`
+}
+
+// Principal is the authenticated caller.
+type Principal struct {
+	UserID int64
+	Roles  []string
+}
+
+func (p Principal) IsAdmin() bool {
+	for _, role := range p.Roles {
+		if role == RoleAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+// Actor names the principal in the audit log.
+func (p Principal) Actor() string {
+	return "user:" + strconv.FormatInt(p.UserID, 10)
+}
+
+// Authenticator resolves a credential taken from a request to a principal.
+type Authenticator interface {
+	Authenticate(ctx context.Context, credential string) (Principal, error)
+}
+
+// Chain tries its authenticators in order and returns the first success.
+type Chain []Authenticator
+
+func (c Chain) Authenticate(ctx context.Context, credential string) (Principal, error) {
+	if credential == "" {
+		return Principal{}, ErrNoCredentials
+	}
+	err := ErrCredentials
+	for _, a := range c {
+		p, aerr := a.Authenticate(ctx, credential)
+		if aerr == nil {
+			return p, nil
+		}
+		err = aerr
+	}
+	return Principal{}, err
+}
+
+// New builds the authenticators of conf, it returns nil when authentication is disabled.
+func New(conf Conf) (Authenticator, error) {
+	if !conf.Enabled {
+		return nil, nil
+	}
+	chain := Chain{NewAPIKeys(conf.APIKeys)}
+	if conf.JWT.HS256Secret != "" || conf.JWT.RS256PublicKey != "" {
+		jwt, err := NewJWT(conf.JWT)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, jwt)
+	}
+	return chain, nil
+}
+
+// Credential extracts the credential of an Authorization (Bearer) or X-API-Key header value pair.
+func Credential(authorization, apiKey string) string {
+	if apiKey != "" {
+		return apiKey
+	}
+	scheme, token, ok := strings.Cut(authorization, " ")
+	if ok && strings.EqualFold(scheme, "Bearer") {
+		return strings.TrimSpace(token)
+	}
+	return ""
+}
+
+type ctxKey struct{}
+
+// NewContext returns the context of an authenticated request, the principal is the actor of its changes.
+func NewContext(ctx context.Context, p Principal) context.Context {
+	ctx = model.WithActor(ctx, p.Actor())
+	return context.WithValue(ctx, ctxKey{}, p)
+}
+
+// FromContext returns the principal of an authenticated request.
+func FromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(ctxKey{}).(Principal)
+	return p, ok
+}
@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func sign(t *testing.T, method jwt.SigningMethod, key interface{}, subject string, expires time.Time) string {
+	t.Helper()
+	claims := Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: subject, ExpiresAt: jwt.NewNumericDate(expires)},
+		Roles:            []string{"reader"},
+	}
+	token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+	require.NoError(t, err)
+	return token
+}
+
+func TestAPIKeys(t *testing.T) {
+	a, err := New(Conf{Enabled: true, APIKeys: []APIKeyConf{{Key: "k1", UserID: 7, Roles: []string{RoleAdmin}}}})
+	require.NoError(t, err)
+
+	p, err := a.Authenticate(context.Background(), "k1")
+	require.NoError(t, err)
+	require.Equal(t, int64(7), p.UserID)
+	require.True(t, p.IsAdmin())
+
+	_, err = a.Authenticate(context.Background(), "k2")
+	require.ErrorIs(t, err, ErrCredentials)
+	_, err = a.Authenticate(context.Background(), "")
+	require.ErrorIs(t, err, ErrNoCredentials)
+
+	a, err = New(Conf{})
+	require.NoError(t, err)
+	require.Nil(t, a)
+}
+
+func TestJWT(t *testing.T) {
+	ctx := context.Background()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	pemFile := filepath.Join(t.TempDir(), "public.pem")
+	require.NoError(t, os.WriteFile(pemFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+
+	a, err := New(Conf{Enabled: true, JWT: JWTConf{HS256Secret: "secret", RS256PublicKey: pemFile}})
+	require.NoError(t, err)
+	expires := time.Now().Add(time.Hour)
+
+	p, err := a.Authenticate(ctx, sign(t, jwt.SigningMethodHS256, []byte("secret"), "11", expires))
+	require.NoError(t, err)
+	require.Equal(t, Principal{UserID: 11, Roles: []string{"reader"}}, p)
+	require.False(t, p.IsAdmin())
+
+	p, err = a.Authenticate(ctx, sign(t, jwt.SigningMethodRS256, key, "12", expires))
+	require.NoError(t, err)
+	require.Equal(t, int64(12), p.UserID)
+
+	for name, token := range map[string]string{
+		"wrong secret": sign(t, jwt.SigningMethodHS256, []byte("other"), "11", expires),
+		"expired":      sign(t, jwt.SigningMethodHS256, []byte("secret"), "11", time.Now().Add(-time.Hour)),
+		"subject":      sign(t, jwt.SigningMethodHS256, []byte("secret"), "alice", expires),
+		"none":         sign(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "11", expires),
+	} {
+		_, err := a.Authenticate(ctx, token)
+		require.ErrorIs(t, err, ErrCredentials, name)
+	}
+}
+
+func TestCredential(t *testing.T) {
+	require.Equal(t, "key", Credential("Bearer token", "key"))
+	require.Equal(t, "token", Credential("bearer token", ""))
+	require.Equal(t, "", Credential("Basic dXNlcg==", ""))
+}
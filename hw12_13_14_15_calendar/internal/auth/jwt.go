@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type JWTConf struct {
+	HS256Secret    string `toml:"hs256-secret"`
+	RS256PublicKey string `toml:"rs256-public-key"` // path to a PEM encoded public key
+	Issuer         string `toml:"issuer"`
+	Audience       string `toml:"audience"`
+}
+
+// Claims of calendar tokens: the subject is the numeric user ID.
+type Claims struct {
+	jwt.RegisteredClaims
+	Roles []string `json:"roles,omitempty"`
+}
+
+// JWT validates HS256 and RS256 tokens against local keys.
+type JWT struct {
+	secret    []byte
+	publicKey *rsa.PublicKey
+	parser    *jwt.Parser
+}
+
+func NewJWT(conf JWTConf) (*JWT, error) {
+	j := &JWT{}
+	methods := []string{}
+	if conf.HS256Secret != "" {
+		j.secret = []byte(conf.HS256Secret)
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+	if conf.RS256PublicKey != "" {
+		data, err := os.ReadFile(conf.RS256PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("can't read RS256 public key: %w", err)
+		}
+		if j.publicKey, err = jwt.ParseRSAPublicKeyFromPEM(data); err != nil {
+			return nil, fmt.Errorf("can't parse RS256 public key: %w", err)
+		}
+		methods = append(methods, jwt.SigningMethodRS256.Alg())
+	}
+
+	options := []jwt.ParserOption{jwt.WithValidMethods(methods), jwt.WithExpirationRequired()}
+	if conf.Issuer != "" {
+		options = append(options, jwt.WithIssuer(conf.Issuer))
+	}
+	if conf.Audience != "" {
+		options = append(options, jwt.WithAudience(conf.Audience))
+	}
+	j.parser = jwt.NewParser(options...)
+	return j, nil
+}
+
+func (j *JWT) key(token *jwt.Token) (interface{}, error) {
+	switch token.Method.Alg() {
+	case jwt.SigningMethodHS256.Alg():
+		return j.secret, nil
+	case jwt.SigningMethodRS256.Alg():
+		return j.publicKey, nil
+	}
+	return nil, fmt.Errorf("unexpected signing method %v", token.Method.Alg())
+}
+
+func (j *JWT) Authenticate(_ context.Context, credential string) (Principal, error) {
+	var claims Claims
+	if _, err := j.parser.ParseWithClaims(credential, &claims, j.key); err != nil {
+		return Principal{}, fmt.Errorf("%w: %v", ErrCredentials, err)
+	}
+	userID, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil || userID <= 0 {
+		return Principal{}, fmt.Errorf("%w: subject %q is not a user ID", ErrCredentials, claims.Subject)
+	}
+	return Principal{UserID: userID, Roles: claims.Roles}, nil
+}
@@ -0,0 +1,236 @@
+package ical
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+)
+
+// Entry is a decoded VEVENT with the report of what could not be mapped.
+// Entries with a non-empty Item.Error carry no usable Event.
+type Entry struct {
+	Event model.Event
+	Item  model.ImportItem
+}
+
+// ignored properties carry iCalendar bookkeeping with no meaning for an imported event.
+var ignored = map[string]bool{
+	"UID": true, "DTSTAMP": true, "CREATED": true, "LAST-MODIFIED": true, "SEQUENCE": true,
+}
+
+// Decode reads a VCALENDAR and maps its VEVENTs to events of userID.
+// VEVENTs with RECURRENCE-ID become overrides of the series with the same UID.
+func Decode(r io.Reader, userID int64) ([]Entry, error) {
+	calendar, err := parse(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	var exceptions []*component
+	series := make(map[string]int)
+	for _, c := range calendar.children {
+		switch c.name {
+		case "VEVENT":
+			if _, ok := c.first("RECURRENCE-ID"); ok {
+				exceptions = append(exceptions, c)
+				continue
+			}
+			entry := decodeEvent(c, userID)
+			if entry.Item.Error == "" && entry.Event.IsRecurring() {
+				series[entry.Item.UID] = len(entries)
+			}
+			entries = append(entries, entry)
+		case "VTIMEZONE":
+			// TZID values are resolved as IANA names
+		default:
+			entries = append(entries, Entry{Item: model.ImportItem{
+				UID:   uid(c),
+				Error: fmt.Sprintf("unsupported component %v", c.name),
+			}})
+		}
+	}
+
+	for _, c := range exceptions {
+		idx, ok := series[uid(c)]
+		if !ok {
+			entries = append(entries, Entry{Item: model.ImportItem{
+				UID:   uid(c),
+				Error: "RECURRENCE-ID without a recurring VEVENT",
+			}})
+			continue
+		}
+		skipped, err := decodeOverride(c, &entries[idx].Event)
+		item := &entries[idx].Item
+		if err != nil {
+			item.Skipped = append(item.Skipped, fmt.Sprintf("RECURRENCE-ID: %v", err))
+			continue
+		}
+		item.Skipped = append(item.Skipped, skipped...)
+	}
+	return entries, nil
+}
+
+func uid(c *component) string {
+	if p, ok := c.first("UID"); ok {
+		return p.value
+	}
+	return ""
+}
+
+// location resolves TZID as an IANA zone; unknown zones fall back to UTC and are reported.
+func location(p property, item *model.ImportItem) (*time.Location, string) {
+	tzid := p.param("TZID")
+	if tzid == "" {
+		return time.UTC, ""
+	}
+	loc, err := model.LoadLocation(tzid)
+	if err != nil {
+		item.Skipped = append(item.Skipped, fmt.Sprintf("%v;TZID=%v: unknown time zone, UTC used", p.name, tzid))
+		return time.UTC, ""
+	}
+	return loc, tzid
+}
+
+func decodeEvent(c *component, userID int64) Entry {
+	entry := Entry{Item: model.ImportItem{UID: uid(c)}}
+	e := &entry.Event
+	item := &entry.Item
+	e.UserID = userID
+
+	var duration time.Duration
+	hasDuration := false
+	for _, p := range c.properties {
+		var err error
+		switch p.name {
+		case "SUMMARY":
+			e.Title = unescape(p.value)
+		case "DESCRIPTION":
+			e.Description = unescape(p.value)
+		case "DTSTART":
+			loc, tzid := location(p, item)
+			e.TimeZone = tzid
+			e.OnTime, err = parseTime(p.value, loc)
+		case "DTEND":
+			loc, _ := location(p, item)
+			e.OffTime, err = parseTime(p.value, loc)
+		case "DURATION":
+			duration, err = parseDuration(p.value)
+			hasDuration = err == nil
+		case "RRULE":
+			e.RRule = p.value
+		case "STATUS":
+			switch status := model.EventStatus(strings.ToLower(p.value)); status {
+			case model.EventStatusConfirmed, model.EventStatusTentative:
+				e.Status = status
+			default:
+				item.Skipped = append(item.Skipped, "STATUS:"+p.value)
+			}
+		case "EXDATE":
+			loc, _ := location(p, item)
+			for _, v := range strings.Split(p.value, ",") {
+				exdate, perr := parseTime(v, loc)
+				if perr != nil {
+					err = perr
+					break
+				}
+				e.ExDates = append(e.ExDates, exdate)
+			}
+		default:
+			if !ignored[p.name] {
+				item.Skipped = append(item.Skipped, p.name)
+			}
+		}
+		if err != nil {
+			item.Error = fmt.Sprintf("%v: %v", p.name, err)
+			return entry
+		}
+	}
+
+	if e.OnTime.IsZero() {
+		item.Error = "DTSTART is missing"
+		return entry
+	}
+	if e.OffTime.IsZero() {
+		switch {
+		case hasDuration:
+			e.OffTime = e.OnTime.Add(duration)
+		case e.OnTime.Hour() == 0 && e.OnTime.Minute() == 0 && e.OnTime.Second() == 0:
+			// an all-day event without DTEND lasts one day
+			e.OffTime = e.OnTime.AddDate(0, 0, 1)
+		default:
+			e.OffTime = e.OnTime
+		}
+	}
+
+	alarms := 0
+	for _, child := range c.children {
+		if child.name != "VALARM" {
+			item.Skipped = append(item.Skipped, child.name)
+			continue
+		}
+		alarms++
+		if alarms > 1 {
+			item.Skipped = append(item.Skipped, "VALARM: only the first alarm is imported")
+			continue
+		}
+		notify, err := decodeAlarm(child, e)
+		if err != nil {
+			item.Skipped = append(item.Skipped, fmt.Sprintf("VALARM: %v", err))
+			continue
+		}
+		e.NotifyTime = notify
+	}
+	return entry
+}
+
+func decodeAlarm(c *component, e *model.Event) (time.Time, error) {
+	trigger, ok := c.first("TRIGGER")
+	if !ok {
+		return time.Time{}, fmt.Errorf("TRIGGER is missing")
+	}
+	if trigger.param("VALUE") == "DATE-TIME" {
+		return parseTime(trigger.value, time.UTC)
+	}
+	d, err := parseDuration(trigger.value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if trigger.param("RELATED") == "END" {
+		return e.OffTime.Add(d), nil
+	}
+	return e.OnTime.Add(d), nil
+}
+
+func decodeOverride(c *component, e *model.Event) ([]string, error) {
+	var skipped []string
+	var o model.EventOverride
+	for _, p := range c.properties {
+		var err error
+		loc, _ := location(p, &model.ImportItem{})
+		switch p.name {
+		case "RECURRENCE-ID":
+			o.RecurrenceID, err = parseTime(p.value, loc)
+		case "SUMMARY":
+			o.Title = unescape(p.value)
+		case "DESCRIPTION":
+			o.Description = unescape(p.value)
+		case "DTSTART":
+			o.OnTime, err = parseTime(p.value, loc)
+		case "DTEND":
+			o.OffTime, err = parseTime(p.value, loc)
+		default:
+			if !ignored[p.name] {
+				skipped = append(skipped, "RECURRENCE-ID "+p.name)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", p.name, err)
+		}
+	}
+	e.Overrides = append(e.Overrides, o)
+	return skipped, nil
+}
@@ -0,0 +1,109 @@
+package ical
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+)
+
+const prodID = "-//cronnoss//hw12_13_14_15_calendar//EN"
+
+// UID is the stable iCalendar identifier of an event.
+func UID(e *model.Event) string {
+	return fmt.Sprintf("event-%d@calendar", e.ID)
+}
+
+// Encode writes events as a VCALENDAR document, stamp is used as DTSTAMP of every VEVENT.
+func Encode(w io.Writer, name string, stamp time.Time, events []model.Event) error {
+	cw := &writer{w: w}
+	cw.line("BEGIN", "VCALENDAR")
+	cw.line("VERSION", "2.0")
+	cw.line("PRODID", prodID)
+	cw.line("CALSCALE", "GREGORIAN")
+	if name != "" {
+		cw.line("X-WR-CALNAME", escape(name))
+	}
+
+	dtstamp := stamp.UTC().Format(formatUTC)
+	for i := range events {
+		e := &events[i]
+		encodeEvent(cw, e, dtstamp)
+		for _, o := range e.Overrides {
+			encodeOverride(cw, e, o, dtstamp)
+		}
+	}
+	cw.line("END", "VCALENDAR")
+	return cw.err
+}
+
+// timeProperty formats DTSTART-like properties in the event zone, or in UTC without one.
+func timeProperty(name string, t time.Time, timeZone string) (string, string) {
+	if timeZone != "" {
+		if loc, err := model.LoadLocation(timeZone); err == nil {
+			return name + ";TZID=" + timeZone, t.In(loc).Format(formatLocal)
+		}
+	}
+	return name, t.UTC().Format(formatUTC)
+}
+
+func encodeEvent(cw *writer, e *model.Event, stamp string) {
+	cw.line("BEGIN", "VEVENT")
+	cw.line("UID", UID(e))
+	cw.line("DTSTAMP", stamp)
+	cw.line(timeProperty("DTSTART", e.OnTime, e.TimeZone))
+	cw.line(timeProperty("DTEND", e.OffTime, e.TimeZone))
+	cw.line("SUMMARY", escape(e.Title))
+	if e.Description != "" {
+		cw.line("DESCRIPTION", escape(e.Description))
+	}
+	if e.Status != "" {
+		cw.line("STATUS", strings.ToUpper(string(e.Status)))
+	}
+	if e.IsRecurring() {
+		cw.line("RRULE", strings.TrimPrefix(e.RRule, "RRULE:"))
+	}
+	for _, exdate := range e.ExDates {
+		cw.line(timeProperty("EXDATE", exdate, e.TimeZone))
+	}
+	if !e.NotifyTime.IsZero() {
+		cw.line("BEGIN", "VALARM")
+		cw.line("ACTION", "DISPLAY")
+		cw.line("DESCRIPTION", escape(e.Title))
+		cw.line("TRIGGER", formatDuration(e.NotifyTime.Sub(e.OnTime)))
+		cw.line("END", "VALARM")
+	}
+	cw.line("END", "VEVENT")
+}
+
+func encodeOverride(cw *writer, e *model.Event, o model.EventOverride, stamp string) {
+	cw.line("BEGIN", "VEVENT")
+	cw.line("UID", UID(e))
+	cw.line("DTSTAMP", stamp)
+	cw.line(timeProperty("RECURRENCE-ID", o.RecurrenceID, e.TimeZone))
+
+	onTime, offTime := o.RecurrenceID, o.RecurrenceID.Add(e.OffTime.Sub(e.OnTime))
+	if !o.OnTime.IsZero() {
+		onTime = o.OnTime
+	}
+	if !o.OffTime.IsZero() {
+		offTime = o.OffTime
+	}
+	cw.line(timeProperty("DTSTART", onTime, e.TimeZone))
+	cw.line(timeProperty("DTEND", offTime, e.TimeZone))
+
+	title, description := e.Title, e.Description
+	if o.Title != "" {
+		title = o.Title
+	}
+	if o.Description != "" {
+		description = o.Description
+	}
+	cw.line("SUMMARY", escape(title))
+	if description != "" {
+		cw.line("DESCRIPTION", escape(description))
+	}
+	cw.line("END", "VEVENT")
+}
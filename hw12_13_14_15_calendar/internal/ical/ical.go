@@ -0,0 +1,300 @@
+// Package ical reads and writes the subset of RFC 5545 iCalendar used by the calendar service.
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ContentType = "text/calendar; charset=utf-8"
+
+	formatUTC   = "20060102T150405Z"
+	formatLocal = "20060102T150405"
+	formatDate  = "20060102"
+	lineLimit   = 75
+)
+
+var (
+	ErrSyntax   = errors.New("ical syntax error")
+	ErrCalendar = errors.New("no VCALENDAR found")
+)
+
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+func (p property) param(name string) string {
+	return p.params[name]
+}
+
+type component struct {
+	name       string
+	properties []property
+	children   []*component
+}
+
+func (c *component) first(name string) (property, bool) {
+	for _, p := range c.properties {
+		if p.name == name {
+			return p, true
+		}
+	}
+	return property{}, false
+}
+
+// unfold joins continuation lines of r and returns the logical content lines.
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		if (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
+
+func parseProperty(line string) (property, error) {
+	p := property{params: make(map[string]string)}
+
+	// the value starts at the first colon outside of a quoted parameter value
+	quoted := false
+	colon := -1
+	for i, ch := range line {
+		if ch == '"' {
+			quoted = !quoted
+		}
+		if ch == ':' && !quoted {
+			colon = i
+			break
+		}
+	}
+	if colon < 0 {
+		return p, fmt.Errorf("%w: %q", ErrSyntax, line)
+	}
+	p.value = line[colon+1:]
+
+	parts := strings.Split(line[:colon], ";")
+	p.name = strings.ToUpper(parts[0])
+	for _, param := range parts[1:] {
+		k, v, ok := strings.Cut(param, "=")
+		if !ok {
+			return p, fmt.Errorf("%w: parameter %q", ErrSyntax, param)
+		}
+		p.params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+	}
+	return p, nil
+}
+
+func parse(r io.Reader) (*component, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var stack []*component
+	var calendar *component
+	for _, line := range lines {
+		p, err := parseProperty(line)
+		if err != nil {
+			return nil, err
+		}
+		switch p.name {
+		case "BEGIN":
+			c := &component{name: strings.ToUpper(p.value)}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, c)
+			}
+			stack = append(stack, c)
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1].name != strings.ToUpper(p.value) {
+				return nil, fmt.Errorf("%w: unexpected END:%v", ErrSyntax, p.value)
+			}
+			c := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 && c.name == "VCALENDAR" {
+				calendar = c
+			}
+		default:
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("%w: property %v outside of a component", ErrSyntax, p.name)
+			}
+			c := stack[len(stack)-1]
+			c.properties = append(c.properties, p)
+		}
+	}
+	if len(stack) != 0 {
+		return nil, fmt.Errorf("%w: missing END:%v", ErrSyntax, stack[len(stack)-1].name)
+	}
+	if calendar == nil {
+		return nil, ErrCalendar
+	}
+	return calendar, nil
+}
+
+func unescape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+			switch s[i] {
+			case 'n', 'N':
+				b.WriteByte('\n')
+			default:
+				b.WriteByte(s[i])
+			}
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+func escape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// parseTime parses DATE and DATE-TIME values, floating times are placed in loc.
+func parseTime(value string, loc *time.Location) (time.Time, error) {
+	switch {
+	case strings.HasSuffix(value, "Z"):
+		return time.Parse(formatUTC, value)
+	case len(value) == len(formatDate):
+		return time.ParseInLocation(formatDate, value, loc)
+	default:
+		return time.ParseInLocation(formatLocal, value, loc)
+	}
+}
+
+// parseDuration parses RFC 5545 durations such as -PT15M or P1DT2H.
+func parseDuration(value string) (time.Duration, error) {
+	s := value
+	sign := time.Duration(1)
+	switch {
+	case strings.HasPrefix(s, "-"):
+		sign = -1
+		s = s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+	if !strings.HasPrefix(s, "P") || len(s) < 3 {
+		return 0, fmt.Errorf("%w: duration %q", ErrSyntax, value)
+	}
+	s = s[1:]
+
+	var d time.Duration
+	inTime := false
+	num := ""
+	for _, ch := range s {
+		switch {
+		case ch == 'T':
+			inTime = true
+		case ch >= '0' && ch <= '9':
+			num += string(ch)
+		default:
+			n, err := strconv.Atoi(num)
+			if err != nil {
+				return 0, fmt.Errorf("%w: duration %q", ErrSyntax, value)
+			}
+			num = ""
+			unit := durationUnit(ch, inTime)
+			if unit == 0 {
+				return 0, fmt.Errorf("%w: duration %q", ErrSyntax, value)
+			}
+			d += time.Duration(n) * unit
+		}
+	}
+	if num != "" {
+		return 0, fmt.Errorf("%w: duration %q", ErrSyntax, value)
+	}
+	return sign * d, nil
+}
+
+func durationUnit(designator rune, inTime bool) time.Duration {
+	switch {
+	case !inTime && designator == 'W':
+		return 7 * 24 * time.Hour
+	case !inTime && designator == 'D':
+		return 24 * time.Hour
+	case inTime && designator == 'H':
+		return time.Hour
+	case inTime && designator == 'M':
+		return time.Minute
+	case inTime && designator == 'S':
+		return time.Second
+	}
+	return 0
+}
+
+func formatDuration(d time.Duration) string {
+	var b strings.Builder
+	if d < 0 {
+		b.WriteByte('-')
+		d = -d
+	}
+	b.WriteString("P")
+	if days := d / (24 * time.Hour); days > 0 {
+		fmt.Fprintf(&b, "%dD", days)
+		d -= days * 24 * time.Hour
+	}
+	if d > 0 || b.Len() <= 2 {
+		b.WriteString("T")
+		if h := d / time.Hour; h > 0 {
+			fmt.Fprintf(&b, "%dH", h)
+			d -= h * time.Hour
+		}
+		if m := d / time.Minute; m > 0 {
+			fmt.Fprintf(&b, "%dM", m)
+			d -= m * time.Minute
+		}
+		if s := d / time.Second; s > 0 || strings.HasSuffix(b.String(), "T") {
+			fmt.Fprintf(&b, "%dS", s)
+		}
+	}
+	return b.String()
+}
+
+// writer emits content lines folded at 75 octets and terminated by CRLF.
+type writer struct {
+	w   io.Writer
+	err error
+}
+
+func (w *writer) line(name, value string) {
+	if w.err != nil {
+		return
+	}
+	line := name + ":" + value
+	var b strings.Builder
+	limit := lineLimit
+	for len(line) > limit {
+		cut := limit
+		// do not split a multi-byte UTF-8 sequence
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = lineLimit - 1 // the leading space of a continuation line counts
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+	_, w.err = io.WriteString(w.w, b.String())
+}
@@ -0,0 +1,117 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+const sample = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:standup@example.com\r\n" +
+	"DTSTART;TZID=Europe/Moscow:20240101T100000\r\n" +
+	"DURATION:PT30M\r\n" +
+	"SUMMARY:Standup\\, daily\r\n" +
+	"DESCRIPTION:Line one\\nline two which is long enough to be folded by the\r\n" +
+	"  producer\r\n" +
+	"RRULE:FREQ=DAILY;COUNT=5\r\n" +
+	"STATUS:TENTATIVE\r\n" +
+	"EXDATE;TZID=Europe/Moscow:20240103T100000\r\n" +
+	"LOCATION:Room 1\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"ACTION:DISPLAY\r\n" +
+	"TRIGGER:-PT15M\r\n" +
+	"END:VALARM\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:standup@example.com\r\n" +
+	"RECURRENCE-ID;TZID=Europe/Moscow:20240102T100000\r\n" +
+	"DTSTART;TZID=Europe/Moscow:20240102T120000\r\n" +
+	"DTEND;TZID=Europe/Moscow:20240102T123000\r\n" +
+	"SUMMARY:Moved standup\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VTODO\r\n" +
+	"UID:todo@example.com\r\n" +
+	"END:VTODO\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestDecode(t *testing.T) {
+	entries, err := Decode(strings.NewReader(sample), 7)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	msk, err := model.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+	onTime := time.Date(2024, 1, 1, 10, 0, 0, 0, msk)
+
+	e := entries[0].Event
+	require.Empty(t, entries[0].Item.Error)
+	require.Equal(t, int64(7), e.UserID)
+	require.Equal(t, "Standup, daily", e.Title)
+	require.Equal(t, "Line one\nline two which is long enough to be folded by the producer", e.Description)
+	require.Equal(t, "Europe/Moscow", e.TimeZone)
+	require.True(t, onTime.Equal(e.OnTime))
+	require.True(t, onTime.Add(30*time.Minute).Equal(e.OffTime))
+	require.True(t, onTime.Add(-15*time.Minute).Equal(e.NotifyTime))
+	require.Equal(t, "FREQ=DAILY;COUNT=5", e.RRule)
+	require.Equal(t, model.EventStatusTentative, e.Status)
+	require.Len(t, e.ExDates, 1)
+	require.Len(t, e.Overrides, 1)
+	require.Equal(t, "Moved standup", e.Overrides[0].Title)
+	require.Equal(t, []string{"LOCATION"}, entries[0].Item.Skipped)
+
+	require.Equal(t, "todo@example.com", entries[1].Item.UID)
+	require.Contains(t, entries[1].Item.Error, "VTODO")
+}
+
+func TestDecodeErrors(t *testing.T) {
+	_, err := Decode(strings.NewReader("BEGIN:VEVENT\r\nEND:VEVENT\r\n"), 1)
+	require.ErrorIs(t, err, ErrCalendar)
+
+	_, err = Decode(strings.NewReader("BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nEND:VCALENDAR\r\n"), 1)
+	require.ErrorIs(t, err, ErrSyntax)
+
+	noStart := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nSUMMARY:x\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+	entries, err := Decode(strings.NewReader(noStart), 1)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "DTSTART is missing", entries[0].Item.Error)
+}
+
+func TestEncodeRoundTrip(t *testing.T) {
+	entries, err := Decode(strings.NewReader(sample), 7)
+	require.NoError(t, err)
+	e := entries[0].Event
+	e.ID = 42
+
+	var buf bytes.Buffer
+	require.NoError(t, Encode(&buf, "Work", time.Now(), []model.Event{e}))
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+		require.LessOrEqual(t, len(line), lineLimit)
+	}
+	require.Contains(t, buf.String(), "UID:event-42@calendar\r\n")
+	require.Contains(t, buf.String(), "TRIGGER:-PT15M\r\n")
+
+	decoded, err := Decode(&buf, 7)
+	require.NoError(t, err)
+	require.Len(t, decoded, 1)
+	got := decoded[0].Event
+	require.Empty(t, decoded[0].Item.Error)
+	require.Equal(t, e.Title, got.Title)
+	require.Equal(t, e.Description, got.Description)
+	require.Equal(t, e.TimeZone, got.TimeZone)
+	require.Equal(t, e.RRule, got.RRule)
+	require.True(t, e.OnTime.Equal(got.OnTime))
+	require.True(t, e.OffTime.Equal(got.OffTime))
+	require.True(t, e.NotifyTime.Equal(got.NotifyTime))
+	require.Len(t, got.ExDates, 1)
+	require.True(t, e.ExDates[0].Equal(got.ExDates[0]))
+	require.Len(t, got.Overrides, 1)
+	require.Equal(t, e.Overrides[0].Title, got.Overrides[0].Title)
+	require.True(t, e.Overrides[0].OnTime.Equal(got.Overrides[0].OnTime))
+}
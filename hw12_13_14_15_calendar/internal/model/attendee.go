@@ -0,0 +1,53 @@
+package model
+
+// PartStat is the participation status of an attendee, the values follow PARTSTAT of RFC 5545.
+type PartStat string
+
+const (
+	PartStatNeedsAction PartStat = "needs-action"
+	PartStatAccepted    PartStat = "accepted"
+	PartStatDeclined    PartStat = "declined"
+	PartStatTentative   PartStat = "tentative"
+)
+
+// Valid reports whether s is a known participation status.
+func (s PartStat) Valid() bool {
+	switch s {
+	case PartStatNeedsAction, PartStatAccepted, PartStatDeclined, PartStatTentative:
+		return true
+	}
+	return false
+}
+
+// Attendee is a user invited to an event of another user.
+type Attendee struct {
+	UserID int64    `json:"userid"`
+	Status PartStat `json:"status"`
+}
+
+// Invitation is an event seen by one of its attendees.
+type Invitation struct {
+	Event  Event    `json:"event"`
+	Status PartStat `json:"status"`
+}
+
+// Attendee returns the attendee of a user.
+func (e Event) Attendee(userID int64) (Attendee, bool) {
+	for _, a := range e.Attendees {
+		if a.UserID == userID {
+			return a, true
+		}
+	}
+	return Attendee{}, false
+}
+
+// Recipients returns the users notified of the event: the owner and the attendees who accepted.
+func (e Event) Recipients() []int64 {
+	recipients := []int64{e.UserID}
+	for _, a := range e.Attendees {
+		if a.Status == PartStatAccepted && a.UserID != e.UserID {
+			recipients = append(recipients, a.UserID)
+		}
+	}
+	return recipients
+}
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"context"
+	"time"
+)
+
+// AuditAction is the kind of change of an event recorded in the audit log.
+type AuditAction string
+
+const (
+	AuditInsert   AuditAction = "insert"
+	AuditUpdate   AuditAction = "update"
+	AuditDelete   AuditAction = "delete"
+	AuditNotified AuditAction = "notified"
+	AuditRestore  AuditAction = "restore"
+	AuditPurge    AuditAction = "purge"
+)
+
+// ActorAnonymous is the actor of changes made without an authenticated principal.
+const ActorAnonymous = "anonymous"
+
+// EventSnapshot is the state of an event in the audit log, with the notification state the event API hides.
+type EventSnapshot struct {
+	Event
+	Notified     bool      `json:"notified"`
+	LastNotified time.Time `json:"lastnotified,omitempty"`
+}
+
+// AuditEntry is a change of an event: Before is nil for an insert and After is nil for a delete or a purge.
+type AuditEntry struct {
+	ID      int64          `json:"id"`
+	EventID int64          `json:"eventid"`
+	Action  AuditAction    `json:"action"`
+	Actor   string         `json:"actor"`
+	Time    time.Time      `json:"time"`
+	Before  *EventSnapshot `json:"before,omitempty"`
+	After   *EventSnapshot `json:"after,omitempty"`
+}
+
+// Snapshot returns the state of e recorded in the audit log.
+func (e Event) Snapshot() *EventSnapshot {
+	e.Conflicts = nil
+	return &EventSnapshot{Event: e, Notified: e.Notified, LastNotified: e.LastNotified}
+}
+
+type actorKey struct{}
+
+// WithActor returns a context whose changes of events are recorded as made by actor.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor of ctx, ActorAnonymous when there is none.
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return ActorAnonymous
+}
@@ -0,0 +1,60 @@
+package model
+
+// Permission is the access a share grants to the events of a calendar.
+type Permission string
+
+const (
+	PermissionFreeBusy Permission = "freebusy"
+	PermissionRead     Permission = "read"
+	PermissionWrite    Permission = "write"
+	PermissionOwner    Permission = "owner"
+)
+
+var permissionLevels = map[Permission]int{
+	PermissionFreeBusy: 1,
+	PermissionRead:     2,
+	PermissionWrite:    3,
+	PermissionOwner:    4,
+}
+
+// Valid reports whether p can be granted by a share.
+func (p Permission) Valid() bool {
+	return p == PermissionFreeBusy || p == PermissionRead || p == PermissionWrite
+}
+
+// Allows reports whether p includes the access of need.
+func (p Permission) Allows(need Permission) bool {
+	return permissionLevels[p] >= permissionLevels[need] && permissionLevels[need] > 0
+}
+
+// Calendar is a named set of events owned by a user. Events without a calendar belong to
+// the implicit personal calendar of their user. Permission is the access of the requesting user.
+type Calendar struct {
+	ID             int64          `json:"id"`
+	OwnerID        int64          `json:"ownerid"`
+	Name           string         `json:"name"`
+	Description    string         `json:"description,omitempty"`
+	ConflictPolicy ConflictPolicy `json:"conflictpolicy,omitempty"`
+	Permission     Permission     `json:"permission,omitempty"`
+}
+
+// CalendarShare grants a user access to a calendar of another user.
+type CalendarShare struct {
+	CalendarID int64      `json:"calendarid"`
+	UserID     int64      `json:"userid"`
+	Permission Permission `json:"permission"`
+}
+
+// FreeBusy strips an event down to the busy interval.
+func (e Event) FreeBusy() Event {
+	return Event{
+		ID:           e.ID,
+		UserID:       e.UserID,
+		CalendarID:   e.CalendarID,
+		OnTime:       e.OnTime,
+		OffTime:      e.OffTime,
+		TimeZone:     e.TimeZone,
+		RecurrenceID: e.RecurrenceID,
+		Status:       e.Status,
+	}
+}
@@ -0,0 +1,55 @@
+package model
+
+import "time"
+
+// EventStatus is the status of an event, the values follow STATUS of RFC 5545. An empty status is confirmed.
+type EventStatus string
+
+const (
+	EventStatusConfirmed EventStatus = "confirmed"
+	EventStatusTentative EventStatus = "tentative"
+)
+
+// Valid reports whether s is a known event status.
+func (s EventStatus) Valid() bool {
+	return s == "" || s == EventStatusConfirmed || s == EventStatusTentative
+}
+
+// ConflictPolicy decides whether an event may overlap another event of the same user.
+// An empty policy of a calendar falls back to the policy of its owner, an empty user policy rejects.
+type ConflictPolicy string
+
+const (
+	ConflictPolicyReject ConflictPolicy = "reject"
+	ConflictPolicyAllow  ConflictPolicy = "allow"
+	ConflictPolicyWarn   ConflictPolicy = "allow-with-warning"
+)
+
+// Valid reports whether p is a known policy or empty.
+func (p ConflictPolicy) Valid() bool {
+	switch p {
+	case "", ConflictPolicyReject, ConflictPolicyAllow, ConflictPolicyWarn:
+		return true
+	}
+	return false
+}
+
+// Conflict is an occurrence of another event overlapping a saved event. Only the busy time is shown,
+// as the other event may be in a calendar the requesting user can't read.
+type Conflict struct {
+	EventID int64       `json:"eventid"`
+	OnTime  time.Time   `json:"ontime"`
+	OffTime time.Time   `json:"offtime"`
+	Status  EventStatus `json:"status,omitempty"`
+}
+
+// IsTentative reports whether the event is tentative, tentative events may overlap any event.
+func (e Event) IsTentative() bool {
+	return e.Status == EventStatusTentative
+}
+
+// Overlaps reports whether the half-open intervals [onTime, offTime) and [begin, end) overlap,
+// so events that only share an endpoint don't.
+func Overlaps(onTime, offTime, begin, end time.Time) bool {
+	return onTime.Before(end) && offTime.After(begin)
+}
@@ -5,12 +5,36 @@ import (
 )
 
 type Event struct {
-	ID          int64     `json:"id"`
-	UserID      int64     `json:"userid"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	OnTime      time.Time `json:"ontime"`
-	OffTime     time.Time `json:"offtime"`
-	NotifyTime  time.Time `json:"notifytime,omitempty"`
-	Notified    bool      `json:"-"`
+	ID           int64           `json:"id"`
+	UserID       int64           `json:"userid"`
+	CalendarID   int64           `json:"calendarid,omitempty"`
+	Title        string          `json:"title"`
+	Description  string          `json:"description"`
+	OnTime       time.Time       `json:"ontime"`
+	OffTime      time.Time       `json:"offtime"`
+	NotifyTime   time.Time       `json:"notifytime,omitempty"`
+	TimeZone     string          `json:"timezone,omitempty"`
+	RRule        string          `json:"rrule,omitempty"`
+	ExDates      []time.Time     `json:"exdates,omitempty"`
+	Overrides    []EventOverride `json:"overrides,omitempty"`
+	RecurrenceID time.Time       `json:"recurrenceid,omitempty"`
+	Attendees    []Attendee      `json:"attendees,omitempty"`
+	Status       EventStatus     `json:"status,omitempty"`
+	Conflicts    []Conflict      `json:"conflicts,omitempty"`
+	Version      int64           `json:"version,omitempty"`
+	DeletedAt    time.Time       `json:"deletedat,omitempty"`
+	Notified     bool            `json:"-"`
+	LastNotified time.Time       `json:"-"`
+	Enqueued     bool            `json:"-"`
+	LastEnqueued time.Time       `json:"-"`
+}
+
+// EventOverride changes a single occurrence of a recurring event.
+// RecurrenceID is the original start of the occurrence, empty fields keep the series values.
+type EventOverride struct {
+	RecurrenceID time.Time `json:"recurrenceid"`
+	Title        string    `json:"title,omitempty"`
+	Description  string    `json:"description,omitempty"`
+	OnTime       time.Time `json:"ontime,omitempty"`
+	OffTime      time.Time `json:"offtime,omitempty"`
 }
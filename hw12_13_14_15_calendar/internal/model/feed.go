@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// FeedToken grants read-only access to the iCalendar feed of a user.
+// ETag and Modified remember the last served feed content.
+type FeedToken struct {
+	Token    string    `json:"token"`
+	UserID   int64     `json:"userid"`
+	Created  time.Time `json:"created"`
+	ETag     string    `json:"-"`
+	Modified time.Time `json:"-"`
+}
+
+type Feed struct {
+	UserID   int64
+	ETag     string
+	Modified time.Time
+	Data     []byte
+}
@@ -0,0 +1,130 @@
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+// Interval is the half-open time range [Start, End).
+type Interval struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// UserBusy is the busy time of one user.
+type UserBusy struct {
+	UserID int64      `json:"userid"`
+	Busy   []Interval `json:"busy"`
+}
+
+// FreeBusy is the busy time of several users in [Begin, End), Busy merges the time of all of them.
+type FreeBusy struct {
+	Begin time.Time  `json:"begin"`
+	End   time.Time  `json:"end"`
+	Busy  []Interval `json:"busy"`
+	Users []UserBusy `json:"users"`
+}
+
+// SlotQuery asks for common free slots of users. Working hours are local "15:04" times
+// of TimeZone, an empty zone is the default zone of the first user.
+type SlotQuery struct {
+	UserIDs   []int64   `json:"userids"`
+	Minutes   int       `json:"minutes"`
+	Begin     time.Time `json:"begin"`
+	End       time.Time `json:"end"`
+	WorkStart string    `json:"workstart,omitempty"`
+	WorkEnd   string    `json:"workend,omitempty"`
+	TimeZone  string    `json:"timezone,omitempty"`
+	Limit     int       `json:"limit,omitempty"`
+}
+
+// MergeIntervals sorts intervals and joins the overlapping and adjacent ones.
+func MergeIntervals(intervals []Interval) []Interval {
+	sorted := make([]Interval, 0, len(intervals))
+	for _, i := range intervals {
+		if i.End.After(i.Start) {
+			sorted = append(sorted, i)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start.Before(sorted[j].Start) })
+
+	merged := []Interval{}
+	for _, i := range sorted {
+		last := len(merged) - 1
+		if last >= 0 && !i.Start.After(merged[last].End) {
+			if i.End.After(merged[last].End) {
+				merged[last].End = i.End
+			}
+			continue
+		}
+		merged = append(merged, i)
+	}
+	return merged
+}
+
+// ClipIntervals cuts intervals to [begin, end) and drops the ones outside.
+func ClipIntervals(intervals []Interval, begin, end time.Time) []Interval {
+	clipped := []Interval{}
+	for _, i := range intervals {
+		if i.Start.Before(begin) {
+			i.Start = begin
+		}
+		if i.End.After(end) {
+			i.End = end
+		}
+		if i.End.After(i.Start) {
+			clipped = append(clipped, i)
+		}
+	}
+	return clipped
+}
+
+// FreeSlots returns up to limit consecutive slots of length d in [begin, end) that are inside
+// the working hours of a day and outside busy, which must be merged. Working hours are minutes
+// from the local midnight of loc, days are built with time.Date so DST changes keep the local hours.
+func FreeSlots(busy []Interval, begin, end time.Time, d time.Duration, workStart, workEnd int,
+	loc *time.Location, limit int,
+) []Interval {
+	slots := []Interval{}
+	if d <= 0 || limit <= 0 {
+		return slots
+	}
+
+	y, m, day := begin.In(loc).Date()
+	for date := time.Date(y, m, day, 0, 0, 0, 0, loc); date.Before(end); {
+		y, m, day = date.Date()
+		start := time.Date(y, m, day, 0, workStart, 0, 0, loc)
+		stop := time.Date(y, m, day, 0, workEnd, 0, 0, loc)
+		if start.Before(begin) {
+			start = begin
+		}
+		if stop.After(end) {
+			stop = end
+		}
+
+		cursor := start
+		for _, b := range busy {
+			if !b.End.After(cursor) {
+				continue
+			}
+			if !b.Start.Before(stop) {
+				break
+			}
+			slots = appendSlots(slots, cursor, b.Start, d, limit)
+			cursor = b.End
+		}
+		slots = appendSlots(slots, cursor, stop, d, limit)
+		if len(slots) >= limit {
+			return slots[:limit]
+		}
+		date = time.Date(y, m, day+1, 0, 0, 0, 0, loc)
+	}
+	return slots
+}
+
+func appendSlots(slots []Interval, from, to time.Time, d time.Duration, limit int) []Interval {
+	for start := from; !start.Add(d).After(to) && len(slots) < limit; start = start.Add(d) {
+		slots = append(slots, Interval{Start: start, End: start.Add(d)})
+	}
+	return slots
+}
@@ -0,0 +1,15 @@
+package model
+
+// ImportItem reports the outcome of importing a single calendar entry.
+// Skipped lists the properties and components that have no Event counterpart.
+type ImportItem struct {
+	UID     string   `json:"uid"`
+	EventID int64    `json:"eventid,omitempty"`
+	Skipped []string `json:"skipped,omitempty"`
+	Error   string   `json:"error,omitempty"`
+}
+
+type ImportReport struct {
+	Imported int          `json:"imported"`
+	Items    []ImportItem `json:"items"`
+}
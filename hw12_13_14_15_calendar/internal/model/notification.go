@@ -2,9 +2,20 @@ package model
 
 import "time"
 
+// NotificationMsg is a notification of an event to a user. Key deduplicates the redeliveries,
+// it is empty in the notifications that did not pass the outbox.
 type NotificationMsg struct {
 	ID     int64
 	Title  string
 	Date   time.Time
 	UserID int64
+	Key    string `json:",omitempty"`
+}
+
+// NotificationDelivery is a received notification the broker keeps until it is settled:
+// Ack drops it, Retry delivers it again later or dead-letters it once its retries are exhausted.
+type NotificationDelivery interface {
+	Msg() NotificationMsg
+	Ack() error
+	Retry(reason error) error
 }
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OutboxEntry is a notification the scheduler enqueued. The relay publishes it and sets Sent,
+// the sender sets Delivered, Attempts and Error count the failed publishing.
+type OutboxEntry struct {
+	ID        int64
+	Msg       NotificationMsg
+	Created   time.Time
+	Sent      time.Time
+	Delivered time.Time
+	Attempts  int
+	Error     string
+}
+
+// NotificationKey is the deduplication key of the notification of an occurrence to a user.
+// An occurrence is identified by its original start, the start of a single event.
+func NotificationKey(occ Event, userID int64) string {
+	start := occ.RecurrenceID
+	if start.IsZero() {
+		start = occ.OnTime
+	}
+	return fmt.Sprintf("%d:%d:%d", occ.ID, start.Unix(), userID)
+}
+
+// RecipientKey returns the key of the notification of the same occurrence as key to another user.
+func RecipientKey(key string, userID int64) string {
+	occurrence := key[:strings.LastIndex(key, ":")+1]
+	return occurrence + strconv.FormatInt(userID, 10)
+}
+
+// ChannelKey is the deduplication key of the delivery of a notification through one channel.
+func ChannelKey(key, channel string) string {
+	return key + "/" + channel
+}
+
+// NotificationMsgs returns the notifications of an occurrence to its recipients.
+func NotificationMsgs(occ Event) []NotificationMsg {
+	recipients := occ.Recipients()
+	msgs := make([]NotificationMsg, 0, len(recipients))
+	for _, userID := range recipients {
+		msgs = append(msgs, NotificationMsg{
+			ID:     occ.ID,
+			Title:  occ.Title,
+			Date:   occ.OnTime,
+			UserID: userID,
+			Key:    NotificationKey(occ, userID),
+		})
+	}
+	return msgs
+}
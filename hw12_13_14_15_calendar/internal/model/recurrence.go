@@ -0,0 +1,268 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+// MaxTime ends the span of endless series.
+var MaxTime = time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC)
+
+func (e *Event) IsRecurring() bool {
+	return e.RRule != ""
+}
+
+// RuleSet builds the RFC 5545 recurrence set of the event starting at OnTime.
+// Occurrences are generated in the event time zone, so they keep the local wall clock across DST changes.
+func (e *Event) RuleSet() (*rrule.Set, error) {
+	loc := e.Location()
+	option, err := rrule.StrToROptionInLocation(strings.TrimPrefix(e.RRule, "RRULE:"), loc)
+	if err != nil {
+		return nil, err
+	}
+	option.Dtstart = e.OnTime.In(loc)
+
+	rule, err := rrule.NewRRule(*option)
+	if err != nil {
+		return nil, err
+	}
+
+	set := &rrule.Set{}
+	set.RRule(rule)
+	for _, exdate := range e.ExDates {
+		set.ExDate(exdate)
+	}
+	return set, nil
+}
+
+// IsFinite reports whether the recurrence rule has COUNT or UNTIL.
+func (e *Event) IsFinite() bool {
+	if !e.IsRecurring() {
+		return true
+	}
+	option, err := rrule.StrToROption(strings.TrimPrefix(e.RRule, "RRULE:"))
+	if err != nil {
+		return true
+	}
+	return option.Count > 0 || !option.Until.IsZero()
+}
+
+func (e *Event) override(recurrenceID time.Time) (EventOverride, bool) {
+	for _, o := range e.Overrides {
+		if o.RecurrenceID.Equal(recurrenceID) {
+			return o, true
+		}
+	}
+	return EventOverride{}, false
+}
+
+// occurrence returns the occurrence starting at start with the matching override applied.
+func (e *Event) occurrence(start time.Time) Event {
+	occ := *e
+	occ.RecurrenceID = start
+	occ.OnTime = start
+	occ.OffTime = start.Add(e.OffTime.Sub(e.OnTime))
+	if !e.NotifyTime.IsZero() {
+		occ.NotifyTime = start.Add(e.NotifyTime.Sub(e.OnTime))
+	}
+
+	if o, ok := e.override(start); ok {
+		if o.Title != "" {
+			occ.Title = o.Title
+		}
+		if o.Description != "" {
+			occ.Description = o.Description
+		}
+		if !o.OnTime.IsZero() {
+			// a moved occurrence keeps its length unless the override ends it
+			occ.OnTime = o.OnTime
+			occ.OffTime = o.OnTime.Add(e.OffTime.Sub(e.OnTime))
+		}
+		if !o.OffTime.IsZero() {
+			occ.OffTime = o.OffTime
+		}
+	}
+	return occ
+}
+
+// Occurrences expands the event into the occurrences overlapping [begin, end).
+// A non-recurring event is returned as is when it overlaps the window.
+func (e *Event) Occurrences(begin, end time.Time) ([]Event, error) {
+	if !e.IsRecurring() {
+		if Overlaps(e.OnTime, e.OffTime, begin, end) {
+			return []Event{*e}, nil
+		}
+		return nil, nil
+	}
+
+	set, err := e.RuleSet()
+	if err != nil {
+		return nil, err
+	}
+
+	var events []Event
+	seen := make(map[int64]bool)
+	duration := e.OffTime.Sub(e.OnTime)
+	for _, start := range set.Between(begin.Add(-duration), end, true) {
+		seen[start.Unix()] = true
+		occ := e.occurrence(start)
+		if Overlaps(occ.OnTime, occ.OffTime, begin, end) {
+			events = append(events, occ)
+		}
+	}
+
+	// overrides may move an occurrence into the window from outside
+	for _, o := range e.Overrides {
+		if seen[o.RecurrenceID.Unix()] {
+			continue
+		}
+		if len(set.Between(o.RecurrenceID, o.RecurrenceID, true)) == 0 {
+			continue
+		}
+		occ := e.occurrence(o.RecurrenceID)
+		if Overlaps(occ.OnTime, occ.OffTime, begin, end) {
+			events = append(events, occ)
+		}
+	}
+	return events, nil
+}
+
+// LastOffTime returns the end of the last occurrence, the series is not expanded for it:
+// with UNTIL it is UNTIL plus the length of an occurrence, with COUNT the count bounds the steps.
+// The second value is false for series without COUNT or UNTIL.
+func (e *Event) LastOffTime() (time.Time, bool) {
+	if !e.IsRecurring() {
+		return e.OffTime, true
+	}
+	option, err := rrule.StrToROptionInLocation(strings.TrimPrefix(e.RRule, "RRULE:"), e.Location())
+	if err != nil {
+		return e.OffTime, true
+	}
+	if option.Count == 0 && option.Until.IsZero() {
+		return time.Time{}, false
+	}
+
+	last := e.OffTime
+	if !option.Until.IsZero() {
+		// no occurrence starts after UNTIL
+		if end := option.Until.Add(e.OffTime.Sub(e.OnTime)); end.After(last) {
+			last = end
+		}
+	} else if set, err := e.RuleSet(); err == nil {
+		if start := set.Before(MaxTime, true); !start.IsZero() {
+			if occ := e.occurrence(start); occ.OffTime.After(last) {
+				last = occ.OffTime
+			}
+		}
+	}
+
+	// an override may move an earlier occurrence past the last one
+	for _, o := range e.Overrides {
+		end := o.OffTime
+		if end.IsZero() && !o.OnTime.IsZero() {
+			end = o.OnTime.Add(e.OffTime.Sub(e.OnTime))
+		}
+		if end.After(last) {
+			last = end
+		}
+	}
+	return last, true
+}
+
+// Span returns the time covered by all occurrences, series without COUNT or UNTIL end at MaxTime.
+func (e *Event) Span() (time.Time, time.Time) {
+	start := e.OnTime
+	for _, o := range e.Overrides {
+		if !o.OnTime.IsZero() && o.OnTime.Before(start) {
+			start = o.OnTime
+		}
+	}
+	end, ok := e.LastOffTime()
+	if !ok {
+		end = MaxTime
+	}
+	return start, end
+}
+
+// NextNotice returns when the next notification of the event is due.
+// The second value is false when all notifications have been sent.
+func (e *Event) NextNotice() (time.Time, bool) {
+	if e.NotifyTime.IsZero() {
+		return time.Time{}, false
+	}
+	if !e.IsRecurring() {
+		return e.NotifyTime, !e.Notified && !e.Enqueued
+	}
+
+	set, err := e.RuleSet()
+	if err != nil {
+		return time.Time{}, false
+	}
+	last := e.lastHandled()
+	next := set.After(last, last.IsZero())
+	if next.IsZero() {
+		return time.Time{}, false
+	}
+	return next.Add(e.NotifyTime.Sub(e.OnTime)), true
+}
+
+// DueOccurrence returns the latest occurrence whose notification time is not after date
+// and which has been neither notified nor enqueued yet.
+func (e *Event) DueOccurrence(date time.Time) (Event, bool) {
+	if e.NotifyTime.IsZero() {
+		return Event{}, false
+	}
+	if !e.IsRecurring() {
+		if e.Notified || e.Enqueued || e.NotifyTime.After(date) {
+			return Event{}, false
+		}
+		return *e, true
+	}
+
+	start, ok := e.lastNotifiable(date)
+	if !ok || !start.After(e.lastHandled()) {
+		return Event{}, false
+	}
+	return e.occurrence(start), true
+}
+
+// MarkNotified marks the event, or its latest due occurrence, as notified.
+func (e *Event) MarkNotified(date time.Time) {
+	if !e.IsRecurring() {
+		e.Notified = true
+		return
+	}
+	if start, ok := e.lastNotifiable(date); ok {
+		e.LastNotified = start
+	}
+}
+
+// MarkEnqueued marks an occurrence returned by DueOccurrence as enqueued, so it is not due anymore.
+func (e *Event) MarkEnqueued(occ Event) {
+	if !e.IsRecurring() {
+		e.Enqueued = true
+		return
+	}
+	if occ.RecurrenceID.After(e.LastEnqueued) {
+		e.LastEnqueued = occ.RecurrenceID
+	}
+}
+
+// lastHandled is the start of the latest occurrence notified or enqueued.
+func (e *Event) lastHandled() time.Time {
+	if e.LastEnqueued.After(e.LastNotified) {
+		return e.LastEnqueued
+	}
+	return e.LastNotified
+}
+
+func (e *Event) lastNotifiable(date time.Time) (time.Time, bool) {
+	set, err := e.RuleSet()
+	if err != nil {
+		return time.Time{}, false
+	}
+	start := set.Before(date.Add(e.OnTime.Sub(e.NotifyTime)), true)
+	return start, !start.IsZero()
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"sync"
+	"time"
+	_ "time/tzdata" // alpine images have no zoneinfo
+)
+
+var locations sync.Map
+
+// LoadLocation is time.LoadLocation with a cache; an empty name means UTC.
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+// Location returns the time zone of the event, or the zone of OnTime when TimeZone is empty or unknown.
+func (e *Event) Location() *time.Location {
+	if e.TimeZone != "" {
+		if loc, err := LoadLocation(e.TimeZone); err == nil {
+			return loc
+		}
+	}
+	return e.OnTime.Location()
+}
+
+// In returns a copy of the event with all times presented in loc.
+func (e Event) In(loc *time.Location) Event {
+	e.OnTime = e.OnTime.In(loc)
+	e.OffTime = e.OffTime.In(loc)
+	if !e.NotifyTime.IsZero() {
+		e.NotifyTime = e.NotifyTime.In(loc)
+	}
+	if !e.RecurrenceID.IsZero() {
+		e.RecurrenceID = e.RecurrenceID.In(loc)
+	}
+	return e
+}
@@ -0,0 +1,12 @@
+package model
+
+// User keeps per-user settings. TimeZone is the IANA zone used when a request does not name one,
+// ConflictPolicy applies to the events of the user outside calendars with their own policy,
+// Email is where the notifications of the user are mailed and Locale is the language tag they are written in.
+type User struct {
+	ID             int64          `json:"id"`
+	TimeZone       string         `json:"timezone"`
+	ConflictPolicy ConflictPolicy `json:"conflictpolicy,omitempty"`
+	Email          string         `json:"email,omitempty"`
+	Locale         string         `json:"locale,omitempty"`
+}
@@ -0,0 +1,53 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// LogConf is the file the notifications are appended to as JSON lines, the application log when empty.
+type LogConf struct {
+	Path string `toml:"path"`
+}
+
+// Log is a local sink of notifications.
+type Log struct {
+	conf LogConf
+	log  Logger
+	mu   sync.Mutex
+}
+
+func NewLog(conf LogConf, log Logger) *Log {
+	return &Log{conf: conf, log: log}
+}
+
+func (c *Log) Name() string {
+	return ChannelLog
+}
+
+func (c *Log) Send(ctx context.Context, n *Notification) error {
+	if c.conf.Path == "" {
+		c.log.Infof("Notification user:%v event:%v %s\n", n.UserID, n.EventID, strings.TrimSpace(n.Body))
+		return nil
+	}
+	line, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Errorf("can't marshal notification:%w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	file, err := os.OpenFile(c.conf.Path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("can't open notification log:%w", err)
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return fmt.Errorf("can't write notification log:%w", err)
+	}
+	return file.Close()
+}
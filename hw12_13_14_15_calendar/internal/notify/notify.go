@@ -0,0 +1,80 @@
+// Package notify delivers event notifications through the channels configured for the sender.
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	ChannelSMTP    = "smtp"
+	ChannelWebhook = "webhook"
+	ChannelLog     = "log"
+)
+
+var (
+	ErrChannel   = errors.New("unknown notification channel")
+	ErrNoAddress = errors.New("recipient has no address")
+)
+
+// Conf lists the enabled channels by name, the log channel alone when none is listed.
+// Templates is the directory of the templates over the built-in ones, Locale is the fallback locale.
+type Conf struct {
+	Channels  []string    `toml:"channels"`
+	Templates string      `toml:"templates"`
+	Locale    string      `toml:"locale"`
+	SMTP      SMTPConf    `toml:"smtp"`
+	Webhook   WebhookConf `toml:"webhook"`
+	Log       LogConf     `toml:"log"`
+}
+
+// Logger is the part of the application logger the log channel writes to.
+type Logger interface {
+	Infof(format string, a ...interface{})
+}
+
+// Channel sends a rendered notification to its recipient. ErrNoAddress means the channel can't reach
+// the recipient, it is not a failure of the channel.
+type Channel interface {
+	Name() string
+	Send(context.Context, *Notification) error
+}
+
+// Notification is a notification rendered for its recipient by the templates of a channel.
+// Payload is the webhook body, the notification itself is posted when it is empty.
+type Notification struct {
+	EventID int64           `json:"eventid"`
+	UserID  int64           `json:"userid"`
+	Email   string          `json:"email,omitempty"`
+	Title   string          `json:"title"`
+	Date    time.Time       `json:"date"`
+	Subject string          `json:"subject,omitempty"`
+	Body    string          `json:"body,omitempty"`
+	HTML    string          `json:"html,omitempty"`
+	Payload json.RawMessage `json:"-"`
+}
+
+// New creates the channels enabled by conf.
+func New(conf Conf, log Logger) ([]Channel, error) {
+	names := conf.Channels
+	if len(names) == 0 {
+		names = []string{ChannelLog}
+	}
+	channels := make([]Channel, 0, len(names))
+	for _, name := range names {
+		switch name {
+		case ChannelSMTP:
+			channels = append(channels, NewSMTP(conf.SMTP))
+		case ChannelWebhook:
+			channels = append(channels, NewWebhook(conf.Webhook))
+		case ChannelLog:
+			channels = append(channels, NewLog(conf.Log, log))
+		default:
+			return nil, fmt.Errorf("%w:%q", ErrChannel, name)
+		}
+	}
+	return channels, nil
+}
@@ -0,0 +1,283 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+type mail struct {
+	from string
+	to   []string
+	data string
+}
+
+// smtpServer is a local stand-in of a mail server accepting one session at a time.
+func smtpServer(t *testing.T) (string, <-chan mail) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	mails := make(chan mail, 10)
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			serveSMTP(conn, mails)
+		}
+	}()
+	return l.Addr().String(), mails
+}
+
+func serveSMTP(conn net.Conn, mails chan<- mail) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	tp.PrintfLine("220 localhost ESMTP")
+	var m mail
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch cmd {
+		case "EHLO", "HELO":
+			tp.PrintfLine("250 localhost")
+		case "MAIL":
+			m = mail{from: strings.TrimSuffix(strings.TrimPrefix(line, "MAIL FROM:<"), ">")}
+			tp.PrintfLine("250 OK")
+		case "RCPT":
+			to := strings.TrimSuffix(strings.TrimPrefix(line, "RCPT TO:<"), ">")
+			if strings.HasSuffix(to, "@invalid") {
+				tp.PrintfLine("550 no such user")
+				continue
+			}
+			m.to = append(m.to, to)
+			tp.PrintfLine("250 OK")
+		case "DATA":
+			tp.PrintfLine("354 go ahead")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			m.data = string(data)
+			mails <- m
+			tp.PrintfLine("250 OK")
+		case "QUIT":
+			tp.PrintfLine("221 bye")
+			return
+		default:
+			tp.PrintfLine("502 not implemented")
+		}
+	}
+}
+
+func data(locale string) Data {
+	msg := model.NotificationMsg{
+		ID: 7, Title: "Standup", Date: time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC), UserID: 42,
+	}
+	event := model.Event{
+		ID:          7,
+		UserID:      42,
+		Title:       "Standup",
+		Description: "Bring <notes>",
+		OnTime:      msg.Date,
+		OffTime:     msg.Date.Add(15 * time.Minute),
+	}
+	user := model.User{ID: 42, TimeZone: "Europe/Berlin", Email: "user42@example.com", Locale: locale}
+	return NewData(msg, event, user)
+}
+
+func notification(t *testing.T) Notification {
+	t.Helper()
+	templates, err := LoadTemplates("", "")
+	require.NoError(t, err)
+	n, err := templates.Render(ChannelSMTP, data(""))
+	require.NoError(t, err)
+	n.HTML = ""
+	return n
+}
+
+func TestTemplates(t *testing.T) {
+	templates, err := LoadTemplates("", "")
+	require.NoError(t, err)
+
+	n, err := templates.Render(ChannelSMTP, data(""))
+	require.NoError(t, err)
+	require.Equal(t, "Reminder: Standup", n.Subject)
+	require.Equal(t, "Standup starts at Fri, 01 Mar 2024 10:00 CET.\n\nBring <notes>\n", n.Body)
+	require.Equal(t, "<p><b>Standup</b> starts at Fri, 01 Mar 2024 10:00 CET.</p>\n<p>Bring &lt;notes&gt;</p>\n", n.HTML)
+	require.Equal(t, "user42@example.com", n.Email)
+	require.Empty(t, n.Payload)
+
+	// a regional locale falls back to its language, an unknown one to English
+	n, err = templates.Render(ChannelSMTP, data("ru_RU"))
+	require.NoError(t, err)
+	require.Equal(t, "Напоминание: Standup", n.Subject)
+	n, err = templates.Render(ChannelSMTP, data("fr"))
+	require.NoError(t, err)
+	require.Equal(t, "Reminder: Standup", n.Subject)
+
+	n, err = templates.Render(ChannelWebhook, data(""))
+	require.NoError(t, err)
+	require.Empty(t, n.Subject)
+	var payload map[string]interface{}
+	require.NoError(t, json.Unmarshal(n.Payload, &payload))
+	require.Equal(t, "Standup", payload["title"])
+	require.Equal(t, "2024-03-01T10:00:00+01:00", payload["start"])
+	require.Equal(t, "2024-03-01T10:15:00+01:00", payload["end"])
+	require.Equal(t, "Europe/Berlin", payload["timezone"])
+
+	n, err = templates.Render(ChannelLog, data(""))
+	require.NoError(t, err)
+	require.Equal(t, "Reminder: Standup at 2024-03-01 10:00 CET\n", n.Body)
+}
+
+func TestTemplatesDir(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, text string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(text), 0o644))
+	}
+	write("de/smtp.subject.tmpl", "Erinnerung:\n{{.Event.Title}}")
+	write("en/log.text.tmpl", "{{.Event.Title}} for user {{.User.ID}}")
+	write("README", "not a template")
+
+	// the directory adds locales and overrides the built-in templates, the config sets the fallback locale
+	templates, err := LoadTemplates(dir, "de")
+	require.NoError(t, err)
+	n, err := templates.Render(ChannelSMTP, data(""))
+	require.NoError(t, err)
+	require.Equal(t, "Erinnerung: Standup", n.Subject)
+	require.Equal(t, "Standup starts at Fri, 01 Mar 2024 10:00 CET.\n\nBring <notes>\n", n.Body)
+	n, err = templates.Render(ChannelLog, data("en"))
+	require.NoError(t, err)
+	require.Equal(t, "Standup for user 42", n.Body)
+
+	write("en/webhook.json.tmpl", `{"title": {{.Event.Title}}}`)
+	templates, err = LoadTemplates(dir, "")
+	require.NoError(t, err)
+	_, err = templates.Render(ChannelWebhook, data(""))
+	require.ErrorIs(t, err, ErrTemplate)
+
+	write("en/smtp.text.tmpl", "{{.Event.Title")
+	_, err = LoadTemplates(dir, "")
+	require.ErrorIs(t, err, ErrTemplate)
+	_, err = LoadTemplates(filepath.Join(dir, "missing"), "")
+	require.ErrorIs(t, err, ErrTemplate)
+}
+
+func TestNew(t *testing.T) {
+	channels, err := New(Conf{}, nil)
+	require.NoError(t, err)
+	require.Len(t, channels, 1)
+	require.Equal(t, ChannelLog, channels[0].Name())
+
+	channels, err = New(Conf{Channels: []string{ChannelSMTP, ChannelWebhook, ChannelLog}}, nil)
+	require.NoError(t, err)
+	require.Len(t, channels, 3)
+
+	_, err = New(Conf{Channels: []string{"pigeon"}}, nil)
+	require.ErrorIs(t, err, ErrChannel)
+}
+
+func TestSMTP(t *testing.T) {
+	addr, mails := smtpServer(t)
+	channel := NewSMTP(SMTPConf{Addr: addr, From: "calendar@example.com"})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	n := notification(t)
+	require.NoError(t, channel.Send(ctx, &n))
+	m := <-mails
+	require.Equal(t, "calendar@example.com", m.from)
+	require.Equal(t, []string{"user42@example.com"}, m.to)
+	// the dot reader turns CRLF into LF
+	require.Contains(t, m.data, "Subject: Reminder: Standup\n")
+	require.Contains(t, m.data, "\n\nStandup starts at Fri, 01 Mar 2024 10:00 CET.\n\nBring <notes>\n")
+
+	// the HTML body is an alternative of the text
+	n.HTML = "<p>Standup</p>"
+	require.NoError(t, channel.Send(ctx, &n))
+	m = <-mails
+	require.Contains(t, m.data, "Content-Type: multipart/alternative; boundary=")
+	require.Contains(t, m.data, "Content-Type: text/html; charset=utf-8\n")
+	require.Contains(t, m.data, "<p>Standup</p>")
+
+	n.Email = "nobody@invalid"
+	require.Error(t, channel.Send(ctx, &n))
+	n.Email = ""
+	require.ErrorIs(t, channel.Send(ctx, &n), ErrNoAddress)
+
+	down := NewSMTP(SMTPConf{Addr: "127.0.0.1:1", From: "calendar@example.com"})
+	n = notification(t)
+	require.Error(t, down.Send(ctx, &n))
+}
+
+func TestWebhook(t *testing.T) {
+	var got Notification
+	status := http.StatusNoContent
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.Equal(t, "sha256="+Sign("secret", body), r.Header.Get(SignatureHeader))
+		require.NoError(t, json.Unmarshal(body, &got))
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	channel := NewWebhook(WebhookConf{URL: srv.URL, Secret: "secret"})
+	n := notification(t)
+	require.NoError(t, channel.Send(context.Background(), &n))
+	require.Equal(t, n.EventID, got.EventID)
+	require.Equal(t, n.Subject, got.Subject)
+	require.True(t, n.Date.Equal(got.Date))
+
+	// a rendered payload is posted as it is
+	n.Payload = json.RawMessage(`{"eventid": 8}`)
+	got = Notification{}
+	require.NoError(t, channel.Send(context.Background(), &n))
+	require.Equal(t, int64(8), got.EventID)
+	require.Empty(t, got.Subject)
+
+	status = http.StatusBadGateway
+	require.Error(t, channel.Send(context.Background(), &n))
+}
+
+func TestLogFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notifications.jsonl")
+	channel := NewLog(LogConf{Path: path}, nil)
+	n := notification(t)
+	require.NoError(t, channel.Send(context.Background(), &n))
+	require.NoError(t, channel.Send(context.Background(), &n))
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	lines := 0
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var got Notification
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &got))
+		require.Equal(t, n.Body, got.Body)
+		lines++
+	}
+	require.Equal(t, 2, lines)
+}
@@ -0,0 +1,137 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// SMTPConf is the mail server the notifications are sent through, Username enables PLAIN authentication.
+type SMTPConf struct {
+	Addr     string `toml:"addr"`
+	Username string `toml:"username"`
+	Password string `toml:"password"`
+	From     string `toml:"from"`
+}
+
+// SMTP mails notifications to the email of the recipient.
+type SMTP struct {
+	conf SMTPConf
+}
+
+func NewSMTP(conf SMTPConf) *SMTP {
+	return &SMTP{conf: conf}
+}
+
+func (c *SMTP) Name() string {
+	return ChannelSMTP
+}
+
+func (c *SMTP) Send(ctx context.Context, n *Notification) error {
+	if n.Email == "" {
+		return ErrNoAddress
+	}
+	host, _, err := net.SplitHostPort(c.conf.Addr)
+	if err != nil {
+		return fmt.Errorf("wrong smtp addr:%w", err)
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", c.conf.Addr)
+	if err != nil {
+		return fmt.Errorf("can't connect to smtp server:%w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("can't greet smtp server:%w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}); err != nil {
+			return fmt.Errorf("can't start tls:%w", err)
+		}
+	}
+	if c.conf.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", c.conf.Username, c.conf.Password, host)); err != nil {
+			return fmt.Errorf("can't authenticate:%w", err)
+		}
+	}
+	if err := client.Mail(c.conf.From); err != nil {
+		return fmt.Errorf("smtp MAIL:%w", err)
+	}
+	if err := client.Rcpt(n.Email); err != nil {
+		return fmt.Errorf("smtp RCPT:%w", err)
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("smtp DATA:%w", err)
+	}
+	if _, err := w.Write(c.message(n)); err != nil {
+		return fmt.Errorf("smtp DATA:%w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("smtp DATA:%w", err)
+	}
+	return client.Quit()
+}
+
+// message is the mail of a notification: the text body, or the text and HTML alternatives
+// when the HTML body is rendered.
+func (c *SMTP) message(n *Notification) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", c.conf.From)
+	fmt.Fprintf(&b, "To: %s\r\n", n.Email)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", n.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	if n.HTML == "" {
+		writePart(&b, "text/plain", n.Body)
+		return b.Bytes()
+	}
+
+	mw := multipart.NewWriter(&b)
+	fmt.Fprintf(&b, "Content-Type: multipart/alternative; boundary=%s\r\n\r\n", mw.Boundary())
+	for _, part := range []struct{ contentType, body string }{
+		{"text/plain", n.Body},
+		{"text/html", n.HTML},
+	} {
+		w, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType + "; charset=utf-8"},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			continue
+		}
+		qp := quotedprintable.NewWriter(w)
+		qp.Write([]byte(crlf(part.body)))
+		qp.Close()
+	}
+	mw.Close()
+	return b.Bytes()
+}
+
+func writePart(b *bytes.Buffer, contentType, body string) {
+	fmt.Fprintf(b, "Content-Type: %s; charset=utf-8\r\n", contentType)
+	b.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+	qp := quotedprintable.NewWriter(b)
+	qp.Write([]byte(crlf(body)))
+	qp.Close()
+}
+
+func crlf(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "\r\n", "\n"), "\n", "\r\n")
+}
@@ -0,0 +1,238 @@
+package notify
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+)
+
+// Parts of a notification a channel template renders, a template is named <channel>.<part>.tmpl.
+const (
+	PartSubject = "subject"
+	PartText    = "text"
+	PartHTML    = "html"
+	PartJSON    = "json"
+)
+
+// DefaultLocale is the locale of the notifications when neither the user nor the config sets one.
+const DefaultLocale = "en"
+
+var ErrTemplate = errors.New("wrong notification template")
+
+// builtin are the templates used when the template directory has no template of a part in any locale.
+//
+//go:embed templates
+var builtin embed.FS
+
+var funcs = map[string]interface{}{
+	// format presents a time by a Go layout
+	"format": func(t time.Time, layout string) string {
+		return t.Format(layout)
+	},
+	// json quotes a value for a JSON payload
+	"json": func(v interface{}) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+}
+
+// Data is what the templates render: the event and the notified occurrence in the time zone of the user.
+type Data struct {
+	Event  model.Event
+	User   model.User
+	Start  time.Time
+	End    time.Time
+	Locale string
+}
+
+// NewData returns the data of msg about event for user. The times are in the zone of the user,
+// the zone of the event when the user has none.
+func NewData(msg model.NotificationMsg, event model.Event, user model.User) Data {
+	loc := event.Location()
+	if user.TimeZone != "" {
+		if userLoc, err := model.LoadLocation(user.TimeZone); err == nil {
+			loc = userLoc
+		}
+	}
+	start := msg.Date
+	if start.IsZero() {
+		start = event.OnTime
+	}
+	event.ID = msg.ID
+	if event.Title == "" {
+		event.Title = msg.Title
+	}
+	if user.ID == 0 {
+		user.ID = msg.UserID
+	}
+	return Data{
+		Event:  event.In(loc),
+		User:   user,
+		Start:  start.In(loc),
+		End:    start.Add(event.OffTime.Sub(event.OnTime)).In(loc),
+		Locale: user.Locale,
+	}
+}
+
+type executor interface {
+	Execute(io.Writer, interface{}) error
+}
+
+// Templates are the notification templates by locale and name, loaded from a directory of locales
+// over the built-in ones: <dir>/<locale>/<channel>.<part>.tmpl.
+type Templates struct {
+	locale    string
+	templates map[string]map[string]executor
+}
+
+// LoadTemplates loads the templates of dir, the built-in templates alone when dir is empty.
+// The locale is the fallback of the users whose locale has no template.
+func LoadTemplates(dir, locale string) (*Templates, error) {
+	if locale == "" {
+		locale = DefaultLocale
+	}
+	t := &Templates{locale: normalizeLocale(locale), templates: map[string]map[string]executor{}}
+	sub, err := fs.Sub(builtin, "templates")
+	if err != nil {
+		return nil, err
+	}
+	if err := t.load(sub); err != nil {
+		return nil, err
+	}
+	if dir != "" {
+		if err := t.load(os.DirFS(dir)); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+func (t *Templates) load(fsys fs.FS) error {
+	locales, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return fmt.Errorf("%w:%w", ErrTemplate, err)
+	}
+	for _, locale := range locales {
+		if !locale.IsDir() {
+			continue
+		}
+		files, err := fs.ReadDir(fsys, locale.Name())
+		if err != nil {
+			return fmt.Errorf("%w:%w", ErrTemplate, err)
+		}
+		for _, file := range files {
+			name, ok := strings.CutSuffix(file.Name(), ".tmpl")
+			if !ok || file.IsDir() {
+				continue
+			}
+			filename := path.Join(locale.Name(), file.Name())
+			data, err := fs.ReadFile(fsys, filename)
+			if err != nil {
+				return fmt.Errorf("%w:%w", ErrTemplate, err)
+			}
+			tmpl, err := parse(name, string(data))
+			if err != nil {
+				return fmt.Errorf("%w %v:%w", ErrTemplate, filename, err)
+			}
+			key := normalizeLocale(locale.Name())
+			if t.templates[key] == nil {
+				t.templates[key] = map[string]executor{}
+			}
+			t.templates[key][name] = tmpl
+		}
+	}
+	return nil
+}
+
+// parse parses HTML parts with html/template to escape the event fields, the rest as text.
+func parse(name, text string) (executor, error) {
+	if strings.HasSuffix(name, "."+PartHTML) {
+		return htmltemplate.New(name).Funcs(funcs).Parse(text)
+	}
+	return template.New(name).Funcs(funcs).Parse(text)
+}
+
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+}
+
+// locales is the lookup order of a locale: the locale, its language, the default locale and English.
+func (t *Templates) locales(locale string) []string {
+	locale = normalizeLocale(locale)
+	var chain []string
+	for locale != "" {
+		chain = append(chain, locale)
+		i := strings.LastIndex(locale, "-")
+		if i < 0 {
+			break
+		}
+		locale = locale[:i]
+	}
+	return append(chain, t.locale, DefaultLocale)
+}
+
+func (t *Templates) lookup(locale, name string) (executor, bool) {
+	for _, l := range t.locales(locale) {
+		if tmpl, ok := t.templates[l][name]; ok {
+			return tmpl, true
+		}
+	}
+	return nil, false
+}
+
+func (t *Templates) execute(locale, channel, part string, data Data) (string, error) {
+	tmpl, ok := t.lookup(locale, channel+"."+part)
+	if !ok {
+		return "", nil
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("%w %v.%v:%w", ErrTemplate, channel, part, err)
+	}
+	return b.String(), nil
+}
+
+// Render renders the notification of data for channel, parts without a template stay empty.
+func (t *Templates) Render(channel string, data Data) (Notification, error) {
+	n := Notification{
+		EventID: data.Event.ID,
+		UserID:  data.User.ID,
+		Email:   data.User.Email,
+		Title:   data.Event.Title,
+		Date:    data.Start,
+	}
+	subject, err := t.execute(data.Locale, channel, PartSubject, data)
+	if err != nil {
+		return n, err
+	}
+	// a subject is a header, it must stay on one line
+	n.Subject = strings.Join(strings.Fields(subject), " ")
+	if n.Body, err = t.execute(data.Locale, channel, PartText, data); err != nil {
+		return n, err
+	}
+	if n.HTML, err = t.execute(data.Locale, channel, PartHTML, data); err != nil {
+		return n, err
+	}
+	payload, err := t.execute(data.Locale, channel, PartJSON, data)
+	if err != nil {
+		return n, err
+	}
+	if payload != "" {
+		if !json.Valid([]byte(payload)) {
+			return n, fmt.Errorf("%w %v.%v:invalid JSON", ErrTemplate, channel, PartJSON)
+		}
+		n.Payload = json.RawMessage(payload)
+	}
+	return n, nil
+}
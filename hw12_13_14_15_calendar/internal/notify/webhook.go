@@ -0,0 +1,72 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// SignatureHeader carries the HMAC-SHA256 of the body keyed by the webhook secret.
+const SignatureHeader = "X-Calendar-Signature"
+
+// WebhookConf is the endpoint the notifications are posted to as JSON, Secret signs the bodies.
+type WebhookConf struct {
+	URL    string `toml:"url"`
+	Secret string `toml:"secret"`
+}
+
+// Webhook posts notifications to an HTTP endpoint, any status but 2xx is a failure.
+type Webhook struct {
+	conf   WebhookConf
+	client *http.Client
+}
+
+func NewWebhook(conf WebhookConf) *Webhook {
+	return &Webhook{conf: conf, client: &http.Client{}}
+}
+
+func (c *Webhook) Name() string {
+	return ChannelWebhook
+}
+
+func (c *Webhook) Send(ctx context.Context, n *Notification) error {
+	body := []byte(n.Payload)
+	if len(body) == 0 {
+		var err error
+		if body, err = json.Marshal(n); err != nil {
+			return fmt.Errorf("can't marshal notification:%w", err)
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.conf.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("can't create webhook request:%w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.conf.Secret != "" {
+		req.Header.Set(SignatureHeader, "sha256="+Sign(c.conf.Secret, body))
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("can't post webhook:%w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook answered %v", resp.Status)
+	}
+	return nil
+}
+
+// Sign returns the hex HMAC-SHA256 of body keyed by secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Code is the machine-readable kind of an Error, shared by the HTTP and gRPC transports.
+type Code string
+
+const (
+	CodeInvalidArgument Code = "invalid_argument"
+	CodeNotFound        Code = "not_found"
+	CodeConflict        Code = "conflict"
+	CodePrecondition    Code = "failed_precondition"
+	CodeUnauthenticated Code = "unauthenticated"
+	CodePermission      Code = "permission_denied"
+	CodeInternal        Code = "internal"
+)
+
+// Error is an application error with the field of the request it refers to.
+// It unwraps to the sentinel it was made from, so errors.Is keeps working.
+type Error struct {
+	Code    Code   `json:"code"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+	err     error
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+// NewError makes an Error of a sentinel error, the message is formatted after the sentinel text.
+func NewError(sentinel error, format string, a ...interface{}) *Error {
+	kind := kindOf(sentinel)
+	return &Error{
+		Code:    kind.code,
+		Field:   kind.field,
+		Message: sentinel.Error() + fmt.Sprintf(format, a...),
+		err:     sentinel,
+	}
+}
+
+type kind struct {
+	code  Code
+	field string
+}
+
+// kinds describes the sentinel errors, the field names match the JSON names of model.Event.
+var kinds = []struct {
+	err error
+	kind
+}{
+	{ErrID, kind{CodeInvalidArgument, "id"}},
+	{ErrUserID, kind{CodeInvalidArgument, "userid"}},
+	{ErrTitle, kind{CodeInvalidArgument, "title"}},
+	{ErrDescription, kind{CodeInvalidArgument, "description"}},
+	{ErrOnTime, kind{CodeInvalidArgument, "ontime"}},
+	{ErrOffTime, kind{CodeInvalidArgument, "offtime"}},
+	{ErrNotifyTime, kind{CodeInvalidArgument, "notifytime"}},
+	{ErrRRule, kind{CodeInvalidArgument, "rrule"}},
+	{ErrTimeZone, kind{CodeInvalidArgument, "timezone"}},
+	{ErrICalendar, kind{CodeInvalidArgument, ""}},
+	{ErrEventNotFound, kind{CodeNotFound, "id"}},
+	{ErrDateBusy, kind{CodeConflict, "ontime"}},
+	{ErrVersionConflict, kind{CodePrecondition, "version"}},
+	{ErrFeedToken, kind{CodeNotFound, "token"}},
+	{ErrCalendarName, kind{CodeInvalidArgument, "name"}},
+	{ErrSharePermission, kind{CodeInvalidArgument, "permission"}},
+	{ErrCalendarNotFound, kind{CodeNotFound, "calendarid"}},
+	{ErrShareNotFound, kind{CodeNotFound, "userid"}},
+	{ErrAttendee, kind{CodeInvalidArgument, "attendees"}},
+	{ErrPartStat, kind{CodeInvalidArgument, "status"}},
+	{ErrInvitationNotFound, kind{CodeNotFound, "userid"}},
+	{ErrDuration, kind{CodeInvalidArgument, "minutes"}},
+	{ErrWorkingHours, kind{CodeInvalidArgument, "workstart"}},
+	{ErrStatus, kind{CodeInvalidArgument, "status"}},
+	{ErrConflictPolicy, kind{CodeInvalidArgument, "conflictpolicy"}},
+	{ErrEmail, kind{CodeInvalidArgument, "email"}},
+	{ErrLocale, kind{CodeInvalidArgument, "locale"}},
+	{ErrRequest, kind{CodeInvalidArgument, ""}},
+	{ErrUnauthenticated, kind{CodeUnauthenticated, ""}},
+	{ErrPermission, kind{CodePermission, "userid"}},
+}
+
+func kindOf(err error) kind {
+	for _, k := range kinds {
+		if errors.Is(err, k.err) {
+			return k.kind
+		}
+	}
+	return kind{code: CodeInternal}
+}
+
+// internalMessage is all the clients see of an internal error, the details are only logged.
+const internalMessage = "internal error"
+
+// ToError converts any error returned by Application to an Error.
+// Errors wrapping a sentinel of this package get its code and field, the rest are internal
+// and keep their text out of the message, the transports log err itself.
+func ToError(err error) *Error {
+	if err == nil {
+		return nil
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+	kind := kindOf(err)
+	if kind.code == CodeInternal {
+		return &Error{Code: kind.code, Message: internalMessage, err: err}
+	}
+	return &Error{Code: kind.code, Field: kind.field, Message: err.Error(), err: err}
+}
+
+// IsValidation reports whether err is a validation error of an event or a request.
+func IsValidation(err error) bool {
+	return ToError(err).Code == CodeInvalidArgument
+}
@@ -0,0 +1,67 @@
+package internalgrpc
+
+import (
+	"context"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const errorDomain = "calendar"
+
+var statusCodes = map[server.Code]codes.Code{
+	server.CodeInvalidArgument: codes.InvalidArgument,
+	server.CodeNotFound:        codes.NotFound,
+	server.CodeConflict:        codes.FailedPrecondition,
+	server.CodePrecondition:    codes.Aborted,
+	server.CodeUnauthenticated: codes.Unauthenticated,
+	server.CodePermission:      codes.PermissionDenied,
+	server.CodeInternal:        codes.Internal,
+}
+
+// StatusError converts an application error to a gRPC status.
+// The code and field travel as ErrorInfo details, fields of invalid arguments also as BadRequest.
+func StatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	e := server.ToError(err)
+	st := status.New(statusCodes[e.Code], e.Message)
+	info := &errdetails.ErrorInfo{Reason: string(e.Code), Domain: errorDomain}
+	if e.Field != "" {
+		info.Metadata = map[string]string{"field": e.Field}
+	}
+	var withDetails *status.Status
+	if e.Code == server.CodeInvalidArgument && e.Field != "" {
+		withDetails, err = st.WithDetails(info, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: e.Field, Description: e.Message}},
+		})
+	} else {
+		withDetails, err = st.WithDetails(info)
+	}
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// errorsInterceptor converts the errors of the handlers to statuses.
+// Internal errors are logged here, the client only gets a generic status.
+func (s *Server) errorsInterceptor(ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if _, ok := status.FromError(err); !ok && server.ToError(err).Code == server.CodeInternal {
+		s.log.Errorf("%s: internal error:%v\n", info.FullMethod, err)
+	}
+	return resp, StatusError(err)
+}
@@ -0,0 +1,47 @@
+package internalgrpc
+
+import (
+	"context"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/pkg/event_service_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s Server) APISnapshotFromSnapshot(snapshot *model.EventSnapshot) *event_service_v1.EventSnapshot {
+	if snapshot == nil {
+		return nil
+	}
+	apiSnapshot := &event_service_v1.EventSnapshot{
+		Event:    s.APIEventFromEvent(&snapshot.Event),
+		Notified: &snapshot.Notified,
+	}
+	if !snapshot.LastNotified.IsZero() {
+		apiSnapshot.LastNotified = timestamppb.New(snapshot.LastNotified)
+	}
+	return apiSnapshot
+}
+
+// GetEventHistory returns the changes of an event from the oldest.
+func (s *Server) GetEventHistory(ctx context.Context, req *event_service_v1.ReqByID,
+) (*event_service_v1.RepAuditEntries, error) {
+	entries, err := s.app.GetEventHistory(ctx, req.GetID())
+	if err != nil {
+		return nil, err
+	}
+	rep := &event_service_v1.RepAuditEntries{}
+	for i := range entries {
+		entry := &entries[i]
+		action := string(entry.Action)
+		rep.Entries = append(rep.Entries, &event_service_v1.AuditEntry{
+			ID:      &entry.ID,
+			EventID: &entry.EventID,
+			Action:  &action,
+			Actor:   &entry.Actor,
+			Time:    timestamppb.New(entry.Time),
+			Before:  s.APISnapshotFromSnapshot(entry.Before),
+			After:   s.APISnapshotFromSnapshot(entry.After),
+		})
+	}
+	return rep, nil
+}
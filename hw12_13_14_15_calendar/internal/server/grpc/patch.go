@@ -0,0 +1,85 @@
+package internalgrpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/pkg/event_service_v1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// PatchEvent changes the fields of an event listed in the field mask. The merged event is validated
+// as a whole by UpdateEvent, and is only saved when the stored event was not changed meanwhile.
+func (s *Server) PatchEvent(ctx context.Context, req *event_service_v1.ReqPatchEvent,
+) (*event_service_v1.RepConflicts, error) {
+	stored, err := s.app.GetEventByID(ctx, req.GetEvent().GetID())
+	if err != nil {
+		return nil, err
+	}
+	paths := req.GetMask().GetPaths()
+	if len(paths) == 0 {
+		paths = setFields(req.GetEvent())
+	}
+	if err := patchEvent(&stored, s.EventFromAPIEvent(req.GetEvent()), paths); err != nil {
+		return nil, err
+	}
+	if err := s.app.UpdateEvent(ctx, &stored); err != nil {
+		return nil, err
+	}
+	return &event_service_v1.RepConflicts{
+		Conflicts: s.APIConflictsFromConflicts(stored.Conflicts), Version: &stored.Version,
+	}, nil
+}
+
+// setFields returns the names of the fields present in an event.
+func setFields(apiEvent *event_service_v1.Event) []string {
+	var paths []string
+	apiEvent.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		paths = append(paths, string(fd.Name()))
+		return true
+	})
+	return paths
+}
+
+// patchEvent copies the fields of paths from patch to event, the paths are the Event field names in any case.
+// ID selects the event and Version, when given, the version to change.
+func patchEvent(event, patch *model.Event, paths []string) error {
+	for _, path := range paths {
+		switch strings.ToLower(path) {
+		case "id":
+		case "version":
+			event.Version = patch.Version
+		case "userid":
+			if patch.UserID != event.UserID {
+				return server.NewError(server.ErrUserID, "(owner of an event can't be changed)")
+			}
+		case "calendarid":
+			event.CalendarID = patch.CalendarID
+		case "title":
+			event.Title = patch.Title
+		case "description":
+			event.Description = patch.Description
+		case "ontime":
+			event.OnTime = patch.OnTime
+		case "offtime":
+			event.OffTime = patch.OffTime
+		case "notifytime":
+			event.NotifyTime = patch.NotifyTime
+		case "timezone":
+			event.TimeZone = patch.TimeZone
+		case "rrule":
+			event.RRule = patch.RRule
+		case "exdates":
+			event.ExDates = patch.ExDates
+		case "overrides":
+			event.Overrides = patch.Overrides
+		case "status":
+			event.Status = patch.Status
+		default:
+			return server.NewError(server.ErrRequest, "(field mask path %q can't be patched)", path)
+		}
+	}
+	return nil
+}
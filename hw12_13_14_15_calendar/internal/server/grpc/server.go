@@ -1,11 +1,13 @@
 package internalgrpc
 
 import (
+	"bytes"
 	"context"
 	"net"
 	"strings"
 	"time"
 
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/auth"
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server"
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/pkg/event_service_v1"
@@ -33,15 +35,16 @@ type Logger interface {
 type Server struct {
 	event_service_v1.UnimplementedEventServiceV1Server
 
-	basesrv *grpc.Server
-	app     server.Application
-	log     Logger
-	host    string
-	port    string
+	basesrv       *grpc.Server
+	app           server.Application
+	authenticator auth.Authenticator
+	log           Logger
+	host          string
+	port          string
 }
 
 func (Server) APIEventFromEvent(event *model.Event) *event_service_v1.Event {
-	return &event_service_v1.Event{
+	apiEvent := &event_service_v1.Event{
 		ID:          &event.ID,
 		UserID:      &event.UserID,
 		Title:       &event.Title,
@@ -50,53 +53,154 @@ func (Server) APIEventFromEvent(event *model.Event) *event_service_v1.Event {
 		OffTime:     timestamppb.New(event.OffTime),
 		NotifyTime:  timestamppb.New(event.NotifyTime),
 	}
+
+	if event.Version != 0 {
+		apiEvent.Version = &event.Version
+	}
+	if event.CalendarID != 0 {
+		apiEvent.CalendarID = &event.CalendarID
+	}
+	if event.Status != "" {
+		status := string(event.Status)
+		apiEvent.Status = &status
+	}
+	if event.TimeZone != "" {
+		apiEvent.TimeZone = &event.TimeZone
+	}
+	if event.IsRecurring() {
+		apiEvent.RRule = &event.RRule
+	}
+	if !event.RecurrenceID.IsZero() {
+		apiEvent.RecurrenceID = timestamppb.New(event.RecurrenceID)
+	}
+	if !event.DeletedAt.IsZero() {
+		apiEvent.DeletedAt = timestamppb.New(event.DeletedAt)
+	}
+	for _, exdate := range event.ExDates {
+		apiEvent.ExDates = append(apiEvent.ExDates, timestamppb.New(exdate))
+	}
+	for i := range event.Attendees {
+		status := string(event.Attendees[i].Status)
+		apiEvent.Attendees = append(apiEvent.Attendees, &event_service_v1.Attendee{
+			UserID: &event.Attendees[i].UserID,
+			Status: &status,
+		})
+	}
+	for i := range event.Overrides {
+		o := &event.Overrides[i]
+		apiEvent.Overrides = append(apiEvent.Overrides, &event_service_v1.EventOverride{
+			RecurrenceID: timestamppb.New(o.RecurrenceID),
+			Title:        &o.Title,
+			Description:  &o.Description,
+			OnTime:       timestamppb.New(o.OnTime),
+			OffTime:      timestamppb.New(o.OffTime),
+		})
+	}
+	return apiEvent
 }
 
 func (Server) EventFromAPIEvent(apiEvent *event_service_v1.Event) *model.Event {
 	event := model.Event{}
 
-	event.ID = *apiEvent.ID
-	event.UserID = *apiEvent.UserID
-	event.Title = *apiEvent.Title
-	event.Description = *apiEvent.Description
-	if err := apiEvent.OnTime.CheckValid(); err == nil {
-		event.OnTime = apiEvent.OnTime.AsTime().Local()
+	event.ID = apiEvent.GetID()
+	event.UserID = apiEvent.GetUserID()
+	event.CalendarID = apiEvent.GetCalendarID()
+	event.Title = apiEvent.GetTitle()
+	event.Description = apiEvent.GetDescription()
+	event.TimeZone = apiEvent.GetTimeZone()
+	event.Status = model.EventStatus(apiEvent.GetStatus())
+	event.Version = apiEvent.GetVersion()
+
+	// timestamps carry no zone, present them in the event zone instead of the server one
+	loc, err := model.LoadLocation(event.TimeZone)
+	if err != nil {
+		loc = time.UTC
 	}
-	if err := apiEvent.OffTime.CheckValid(); err == nil {
-		event.OffTime = apiEvent.OffTime.AsTime().Local()
+	if err := apiEvent.GetOnTime().CheckValid(); err == nil {
+		event.OnTime = apiEvent.OnTime.AsTime().In(loc)
 	}
-	if err := apiEvent.NotifyTime.CheckValid(); err == nil {
-		event.NotifyTime = apiEvent.NotifyTime.AsTime().Local()
+	if err := apiEvent.GetOffTime().CheckValid(); err == nil {
+		event.OffTime = apiEvent.OffTime.AsTime().In(loc)
+	}
+	if err := apiEvent.GetNotifyTime().CheckValid(); err == nil {
+		event.NotifyTime = apiEvent.NotifyTime.AsTime().In(loc)
+	}
+	event.RRule = apiEvent.GetRRule()
+	for _, exdate := range apiEvent.GetExDates() {
+		if err := exdate.CheckValid(); err == nil {
+			event.ExDates = append(event.ExDates, exdate.AsTime().In(loc))
+		}
+	}
+	for _, attendee := range apiEvent.GetAttendees() {
+		event.Attendees = append(event.Attendees, model.Attendee{
+			UserID: attendee.GetUserID(),
+			Status: model.PartStat(attendee.GetStatus()),
+		})
+	}
+	for _, o := range apiEvent.GetOverrides() {
+		override := model.EventOverride{
+			Title:       o.GetTitle(),
+			Description: o.GetDescription(),
+		}
+		if err := o.RecurrenceID.CheckValid(); err == nil {
+			override.RecurrenceID = o.RecurrenceID.AsTime().In(loc)
+		}
+		if err := o.OnTime.CheckValid(); err == nil {
+			override.OnTime = o.OnTime.AsTime().In(loc)
+		}
+		if err := o.OffTime.CheckValid(); err == nil {
+			override.OffTime = o.OffTime.AsTime().In(loc)
+		}
+		event.Overrides = append(event.Overrides, override)
 	}
 
 	return &event
 }
 
+func (Server) APIConflictsFromConflicts(conflicts []model.Conflict) []*event_service_v1.Conflict {
+	apiConflicts := make([]*event_service_v1.Conflict, len(conflicts))
+	for i := range conflicts {
+		status := string(conflicts[i].Status)
+		apiConflicts[i] = &event_service_v1.Conflict{
+			EventID: &conflicts[i].EventID,
+			OnTime:  timestamppb.New(conflicts[i].OnTime),
+			OffTime: timestamppb.New(conflicts[i].OffTime),
+			Status:  &status,
+		}
+	}
+	return apiConflicts
+}
+
 func (s *Server) InsertEvent(ctx context.Context, req *event_service_v1.ReqByEvent) (*event_service_v1.RepID, error) {
 	event := s.EventFromAPIEvent(req.Event)
 	if err := s.app.InsertEvent(ctx, event); err != nil {
 		return nil, err
 	}
-	return &event_service_v1.RepID{ID: &event.ID}, nil
+	return &event_service_v1.RepID{
+		ID: &event.ID, Conflicts: s.APIConflictsFromConflicts(event.Conflicts), Version: &event.Version,
+	}, nil
 }
 
-func (s Server) UpdateEvent(ctx context.Context, req *event_service_v1.ReqByEvent) (*emptypb.Empty, error) {
+func (s Server) UpdateEvent(ctx context.Context, req *event_service_v1.ReqByEvent,
+) (*event_service_v1.RepConflicts, error) {
 	event := s.EventFromAPIEvent(req.Event)
 	if err := s.app.UpdateEvent(ctx, event); err != nil {
 		return nil, err
 	}
-	return &emptypb.Empty{}, nil
+	return &event_service_v1.RepConflicts{
+		Conflicts: s.APIConflictsFromConflicts(event.Conflicts), Version: &event.Version,
+	}, nil
 }
 
 func (s Server) DeleteEvent(ctx context.Context, req *event_service_v1.ReqByID) (*emptypb.Empty, error) {
-	if err := s.app.DeleteEvent(ctx, *req.ID); err != nil {
+	if err := s.app.DeleteEvent(ctx, req.GetID()); err != nil {
 		return nil, err
 	}
 	return new(emptypb.Empty), nil
 }
 
 func (s *Server) GetEventByID(ctx context.Context, req *event_service_v1.ReqByID) (*event_service_v1.RepEvents, error) {
-	event, err := s.app.GetEventByID(ctx, *req.ID)
+	event, err := s.app.GetEventByID(ctx, req.GetID())
 	_ = event
 	if err != nil {
 		return nil, err
@@ -111,7 +215,7 @@ func (s *Server) GetAllEvents(
 	ctx context.Context,
 	req *event_service_v1.ReqByUser,
 ) (*event_service_v1.RepEvents, error) {
-	events, err := s.app.GetAllEvents(ctx, *req.UserID)
+	events, err := s.app.GetAllEvents(ctx, req.GetUserID())
 	if err != nil {
 		return nil, err
 	}
@@ -129,7 +233,7 @@ func (s *Server) GetAllEventsDay(
 	ctx context.Context,
 	req *event_service_v1.ReqByUserByDate,
 ) (*event_service_v1.RepEvents, error) {
-	events, err := s.app.GetAllEventsDay(ctx, *req.UserID, req.Date.AsTime().Local())
+	events, err := s.app.GetAllEventsDay(ctx, req.GetUserID(), req.Date.AsTime(), req.GetTimeZone())
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +251,7 @@ func (s *Server) GetAllEventsWeek(
 	ctx context.Context,
 	req *event_service_v1.ReqByUserByDate,
 ) (*event_service_v1.RepEvents, error) {
-	events, err := s.app.GetAllEventsWeek(ctx, *req.UserID, req.Date.AsTime().Local())
+	events, err := s.app.GetAllEventsWeek(ctx, req.GetUserID(), req.Date.AsTime(), req.GetTimeZone())
 	if err != nil {
 		return nil, err
 	}
@@ -165,7 +269,231 @@ func (s *Server) GetAllEventsMonth(
 	ctx context.Context,
 	req *event_service_v1.ReqByUserByDate,
 ) (*event_service_v1.RepEvents, error) {
-	events, err := s.app.GetAllEventsMonth(ctx, *req.UserID, req.Date.AsTime().Local())
+	events, err := s.app.GetAllEventsMonth(ctx, req.GetUserID(), req.Date.AsTime(), req.GetTimeZone())
+	if err != nil {
+		return nil, err
+	}
+
+	rep := event_service_v1.RepEvents{}
+	rep.Event = make([]*event_service_v1.Event, len(events))
+	for i, event := range events {
+		event := event
+		rep.Event[i] = s.APIEventFromEvent(&event)
+	}
+	return &rep, nil
+}
+
+func (s *Server) GetUser(ctx context.Context, req *event_service_v1.ReqByUser) (*event_service_v1.RepUser, error) {
+	user, err := s.app.GetUser(ctx, req.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+	policy := string(user.ConflictPolicy)
+	return &event_service_v1.RepUser{User: &event_service_v1.User{
+		ID: &user.ID, TimeZone: &user.TimeZone, ConflictPolicy: &policy, Email: &user.Email,
+		Locale: &user.Locale,
+	}}, nil
+}
+
+func (s *Server) UpdateUser(ctx context.Context, req *event_service_v1.ReqByUserSettings) (*emptypb.Empty, error) {
+	user := model.User{
+		ID:             req.GetUser().GetID(),
+		TimeZone:       req.GetUser().GetTimeZone(),
+		ConflictPolicy: model.ConflictPolicy(req.GetUser().GetConflictPolicy()),
+		Email:          req.GetUser().GetEmail(),
+		Locale:         req.GetUser().GetLocale(),
+	}
+	if err := s.app.UpdateUser(ctx, &user); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) ImportEvents(ctx context.Context, req *event_service_v1.ReqImport,
+) (*event_service_v1.RepImport, error) {
+	report, err := s.app.ImportEvents(ctx, req.GetUserID(), bytes.NewReader(req.GetData()))
+	if err != nil {
+		return nil, err
+	}
+
+	imported := int64(report.Imported)
+	rep := event_service_v1.RepImport{Imported: &imported}
+	rep.Items = make([]*event_service_v1.ImportItem, len(report.Items))
+	for i, item := range report.Items {
+		item := item
+		rep.Items[i] = &event_service_v1.ImportItem{
+			UID:     &item.UID,
+			EventID: &item.EventID,
+			Skipped: item.Skipped,
+			Error:   &item.Error,
+		}
+	}
+	return &rep, nil
+}
+
+func (s *Server) ExportEvents(ctx context.Context, req *event_service_v1.ReqByUser,
+) (*event_service_v1.RepExport, error) {
+	var buf bytes.Buffer
+	if err := s.app.ExportEvents(ctx, req.GetUserID(), &buf); err != nil {
+		return nil, err
+	}
+	return &event_service_v1.RepExport{Data: buf.Bytes()}, nil
+}
+
+func (Server) APIFeedTokenFromFeedToken(t *model.FeedToken) *event_service_v1.FeedToken {
+	return &event_service_v1.FeedToken{
+		Token:   &t.Token,
+		UserID:  &t.UserID,
+		Created: timestamppb.New(t.Created),
+	}
+}
+
+func (s *Server) CreateFeedToken(ctx context.Context, req *event_service_v1.ReqByUser,
+) (*event_service_v1.RepFeedTokens, error) {
+	token, err := s.app.CreateFeedToken(ctx, req.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+	return &event_service_v1.RepFeedTokens{
+		Tokens: []*event_service_v1.FeedToken{s.APIFeedTokenFromFeedToken(&token)},
+	}, nil
+}
+
+func (s *Server) GetFeedTokens(ctx context.Context, req *event_service_v1.ReqByUser,
+) (*event_service_v1.RepFeedTokens, error) {
+	tokens, err := s.app.GetFeedTokens(ctx, req.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+	rep := event_service_v1.RepFeedTokens{Tokens: make([]*event_service_v1.FeedToken, len(tokens))}
+	for i := range tokens {
+		rep.Tokens[i] = s.APIFeedTokenFromFeedToken(&tokens[i])
+	}
+	return &rep, nil
+}
+
+func (s *Server) RevokeFeedToken(ctx context.Context, req *event_service_v1.ReqFeedToken,
+) (*emptypb.Empty, error) {
+	if err := s.app.RevokeFeedToken(ctx, req.GetUserID(), req.GetToken()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (Server) APICalendarFromCalendar(c *model.Calendar) *event_service_v1.Calendar {
+	permission := string(c.Permission)
+	policy := string(c.ConflictPolicy)
+	return &event_service_v1.Calendar{
+		ID:             &c.ID,
+		OwnerID:        &c.OwnerID,
+		Name:           &c.Name,
+		Description:    &c.Description,
+		Permission:     &permission,
+		ConflictPolicy: &policy,
+	}
+}
+
+func (Server) CalendarFromAPICalendar(apiCalendar *event_service_v1.Calendar) *model.Calendar {
+	return &model.Calendar{
+		ID:             apiCalendar.GetID(),
+		OwnerID:        apiCalendar.GetOwnerID(),
+		Name:           apiCalendar.GetName(),
+		Description:    apiCalendar.GetDescription(),
+		ConflictPolicy: model.ConflictPolicy(apiCalendar.GetConflictPolicy()),
+	}
+}
+
+func (s *Server) CreateCalendar(ctx context.Context, req *event_service_v1.ReqByCalendar,
+) (*event_service_v1.RepCalendars, error) {
+	calendar := s.CalendarFromAPICalendar(req.GetCalendar())
+	if err := s.app.CreateCalendar(ctx, calendar); err != nil {
+		return nil, err
+	}
+	return &event_service_v1.RepCalendars{
+		Calendars: []*event_service_v1.Calendar{s.APICalendarFromCalendar(calendar)},
+	}, nil
+}
+
+func (s *Server) UpdateCalendar(ctx context.Context, req *event_service_v1.ReqByCalendar) (*emptypb.Empty, error) {
+	if err := s.app.UpdateCalendar(ctx, s.CalendarFromAPICalendar(req.GetCalendar())); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) DeleteCalendar(ctx context.Context, req *event_service_v1.ReqByID) (*emptypb.Empty, error) {
+	if err := s.app.DeleteCalendar(ctx, req.GetID()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) GetCalendar(ctx context.Context, req *event_service_v1.ReqByID,
+) (*event_service_v1.RepCalendars, error) {
+	calendar, err := s.app.GetCalendar(ctx, req.GetID())
+	if err != nil {
+		return nil, err
+	}
+	return &event_service_v1.RepCalendars{
+		Calendars: []*event_service_v1.Calendar{s.APICalendarFromCalendar(&calendar)},
+	}, nil
+}
+
+func (s *Server) GetCalendars(ctx context.Context, req *event_service_v1.ReqByUser,
+) (*event_service_v1.RepCalendars, error) {
+	calendars, err := s.app.GetCalendars(ctx, req.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+	rep := event_service_v1.RepCalendars{Calendars: make([]*event_service_v1.Calendar, len(calendars))}
+	for i := range calendars {
+		rep.Calendars[i] = s.APICalendarFromCalendar(&calendars[i])
+	}
+	return &rep, nil
+}
+
+func (s *Server) ShareCalendar(ctx context.Context, req *event_service_v1.ReqByCalendarShare,
+) (*emptypb.Empty, error) {
+	share := model.CalendarShare{
+		CalendarID: req.GetShare().GetCalendarID(),
+		UserID:     req.GetShare().GetUserID(),
+		Permission: model.Permission(req.GetShare().GetPermission()),
+	}
+	if err := s.app.ShareCalendar(ctx, &share); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) UnshareCalendar(ctx context.Context, req *event_service_v1.ReqByCalendarShare,
+) (*emptypb.Empty, error) {
+	if err := s.app.UnshareCalendar(ctx, req.GetShare().GetCalendarID(), req.GetShare().GetUserID()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) GetCalendarShares(ctx context.Context, req *event_service_v1.ReqByID,
+) (*event_service_v1.RepCalendarShares, error) {
+	shares, err := s.app.GetCalendarShares(ctx, req.GetID())
+	if err != nil {
+		return nil, err
+	}
+	rep := event_service_v1.RepCalendarShares{Shares: make([]*event_service_v1.CalendarShare, len(shares))}
+	for i := range shares {
+		permission := string(shares[i].Permission)
+		rep.Shares[i] = &event_service_v1.CalendarShare{
+			CalendarID: &shares[i].CalendarID,
+			UserID:     &shares[i].UserID,
+			Permission: &permission,
+		}
+	}
+	return &rep, nil
+}
+
+func (s *Server) GetCalendarsEvents(ctx context.Context, req *event_service_v1.ReqByCalendarsByRange,
+) (*event_service_v1.RepEvents, error) {
+	events, err := s.app.GetCalendarsEvents(ctx, req.GetCalendarIDs(), req.GetBegin().AsTime(), req.GetEnd().AsTime())
 	if err != nil {
 		return nil, err
 	}
@@ -179,6 +507,94 @@ func (s *Server) GetAllEventsMonth(
 	return &rep, nil
 }
 
+func (s *Server) InviteAttendee(ctx context.Context, req *event_service_v1.ReqByAttendee) (*emptypb.Empty, error) {
+	if err := s.app.InviteAttendee(ctx, req.GetEventID(), req.GetUserID()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) RespondInvitation(ctx context.Context, req *event_service_v1.ReqByAttendee,
+) (*emptypb.Empty, error) {
+	err := s.app.RespondInvitation(ctx, req.GetEventID(), req.GetUserID(), model.PartStat(req.GetStatus()))
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) RemoveAttendee(ctx context.Context, req *event_service_v1.ReqByAttendee) (*emptypb.Empty, error) {
+	if err := s.app.RemoveAttendee(ctx, req.GetEventID(), req.GetUserID()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) GetInvitations(ctx context.Context, req *event_service_v1.ReqByUser,
+) (*event_service_v1.RepInvitations, error) {
+	invitations, err := s.app.GetInvitations(ctx, req.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+	rep := event_service_v1.RepInvitations{Invitations: make([]*event_service_v1.Invitation, len(invitations))}
+	for i := range invitations {
+		status := string(invitations[i].Status)
+		rep.Invitations[i] = &event_service_v1.Invitation{
+			Event:  s.APIEventFromEvent(&invitations[i].Event),
+			Status: &status,
+		}
+	}
+	return &rep, nil
+}
+
+func (Server) APIIntervalsFromIntervals(intervals []model.Interval) []*event_service_v1.Interval {
+	apiIntervals := make([]*event_service_v1.Interval, len(intervals))
+	for i := range intervals {
+		apiIntervals[i] = &event_service_v1.Interval{
+			Start: timestamppb.New(intervals[i].Start),
+			End:   timestamppb.New(intervals[i].End),
+		}
+	}
+	return apiIntervals
+}
+
+func (s *Server) GetFreeBusy(ctx context.Context, req *event_service_v1.ReqFreeBusy,
+) (*event_service_v1.RepFreeBusy, error) {
+	fb, err := s.app.GetFreeBusy(ctx, req.GetUserIDs(), req.GetBegin().AsTime(), req.GetEnd().AsTime())
+	if err != nil {
+		return nil, err
+	}
+	rep := event_service_v1.RepFreeBusy{
+		Busy:  s.APIIntervalsFromIntervals(fb.Busy),
+		Users: make([]*event_service_v1.UserBusy, len(fb.Users)),
+	}
+	for i := range fb.Users {
+		rep.Users[i] = &event_service_v1.UserBusy{
+			UserID: &fb.Users[i].UserID,
+			Busy:   s.APIIntervalsFromIntervals(fb.Users[i].Busy),
+		}
+	}
+	return &rep, nil
+}
+
+func (s *Server) FindSlots(ctx context.Context, req *event_service_v1.ReqSlots,
+) (*event_service_v1.RepIntervals, error) {
+	slots, err := s.app.FindSlots(ctx, model.SlotQuery{
+		UserIDs:   req.GetUserIDs(),
+		Minutes:   int(req.GetMinutes()),
+		Begin:     req.GetBegin().AsTime(),
+		End:       req.GetEnd().AsTime(),
+		WorkStart: req.GetWorkStart(),
+		WorkEnd:   req.GetWorkEnd(),
+		TimeZone:  req.GetTimeZone(),
+		Limit:     int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &event_service_v1.RepIntervals{Intervals: s.APIIntervalsFromIntervals(slots)}, nil
+}
+
 func NewServer(log Logger, app server.Application, host, port string) (*Server, *grpc.Server) {
 	unarayLoggerEnricherIntercepter := func(ctx context.Context,
 		req interface{},
@@ -209,20 +625,48 @@ func NewServer(log Logger, app server.Application, host, port string) (*Server,
 		return handler(ctx, req)
 	}
 
-	basesrv := grpc.NewServer(grpc.UnaryInterceptor(unarayLoggerEnricherIntercepter))
-
 	serverGrpc := &Server{
 		log:                               log,
 		app:                               app,
-		basesrv:                           basesrv,
 		host:                              host,
 		port:                              port,
 		UnimplementedEventServiceV1Server: event_service_v1.UnimplementedEventServiceV1Server{},
 	}
+	serverGrpc.basesrv = grpc.NewServer(grpc.ChainUnaryInterceptor(
+		unarayLoggerEnricherIntercepter, serverGrpc.errorsInterceptor, serverGrpc.authInterceptor))
 
 	event_service_v1.RegisterEventServiceV1Server(serverGrpc.basesrv, serverGrpc)
 
-	return serverGrpc, basesrv
+	return serverGrpc, serverGrpc.basesrv
+}
+
+// SetAuthenticator enables authentication of the calls, nil disables it.
+func (s *Server) SetAuthenticator(a auth.Authenticator) {
+	s.authenticator = a
+}
+
+// authInterceptor puts the principal of the call credentials into the context.
+// Credentials are taken from the x-api-key metadata or from an authorization Bearer token.
+func (s *Server) authInterceptor(ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if s.authenticator == nil {
+		return handler(ctx, req)
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	first := func(key string) string {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+	p, err := s.authenticator.Authenticate(ctx, auth.Credential(first("authorization"), first("x-api-key")))
+	if err != nil {
+		return nil, server.NewError(server.ErrUnauthenticated, "(%v)", err)
+	}
+	return handler(auth.NewContext(ctx, p), req)
 }
 
 func (s *Server) Start(context.Context) error {
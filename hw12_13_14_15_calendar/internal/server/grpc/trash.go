@@ -0,0 +1,32 @@
+package internalgrpc
+
+import (
+	"context"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/pkg/event_service_v1"
+)
+
+// GetTrash returns the deleted events of a user that can still be restored.
+func (s *Server) GetTrash(ctx context.Context, req *event_service_v1.ReqByUser) (*event_service_v1.RepEvents, error) {
+	events, err := s.app.GetTrash(ctx, req.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+	rep := event_service_v1.RepEvents{}
+	for i := range events {
+		rep.Event = append(rep.Event, s.APIEventFromEvent(&events[i]))
+	}
+	return &rep, nil
+}
+
+// RestoreEvent moves an event back from the trash of its owner.
+func (s *Server) RestoreEvent(ctx context.Context, req *event_service_v1.ReqTrashEvent,
+) (*event_service_v1.RepID, error) {
+	event, err := s.app.RestoreEvent(ctx, req.GetUserID(), req.GetID())
+	if err != nil {
+		return nil, err
+	}
+	return &event_service_v1.RepID{
+		ID: &event.ID, Conflicts: s.APIConflictsFromConflicts(event.Conflicts), Version: &event.Version,
+	}, nil
+}
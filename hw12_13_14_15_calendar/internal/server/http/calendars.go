@@ -0,0 +1,213 @@
+package internalhttp
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server"
+)
+
+func (s *Server) listUserCalendars(w http.ResponseWriter, r *http.Request, userID int64) {
+	calendars, err := s.app.GetCalendars(r.Context(), userID)
+	if err != nil {
+		s.writeError(w, statusCode(err), err)
+		return
+	}
+	s.writeJSON(w, http.StatusOK, calendars)
+}
+
+func (s *Server) createUserCalendar(w http.ResponseWriter, r *http.Request, userID int64) {
+	var calendar model.Calendar
+	if !s.decodeResource(w, r, &calendar) {
+		return
+	}
+	if calendar.OwnerID != 0 && calendar.OwnerID != userID {
+		s.writeError(w, http.StatusUnprocessableEntity,
+			server.NewError(server.ErrUserID, "(ownerid %v of the body differs from the path)", calendar.OwnerID))
+		return
+	}
+	calendar.ID = 0
+	calendar.OwnerID = userID
+
+	if err := s.app.CreateCalendar(r.Context(), &calendar); err != nil {
+		s.writeError(w, statusCode(err), err)
+		return
+	}
+	w.Header().Set("Location", calendarURL(calendar.ID))
+	s.writeJSON(w, http.StatusCreated, calendar)
+}
+
+// Calendars serves /calendars/{id}, /calendars/{id}/events, /calendars/{id}/shares
+// and /calendars/{id}/shares/{userid}.
+func (s *Server) Calendars(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, calendarsPath), "/")
+	id, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || id <= 0 {
+		s.writeError(w, http.StatusNotFound, server.NewError(server.ErrCalendarNotFound, "(%q)", r.URL.Path))
+		return
+	}
+
+	switch {
+	case len(parts) == 1:
+		s.calendar(w, r, id)
+	case len(parts) == 2 && parts[1] == "events":
+		s.calendarEvents(w, r, id)
+	case len(parts) == 2 && parts[1] == "shares":
+		if r.Method != http.MethodGet {
+			s.methodNotAllowed(w, http.MethodGet)
+			return
+		}
+		shares, err := s.app.GetCalendarShares(r.Context(), id)
+		if err != nil {
+			s.writeError(w, statusCode(err), err)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, shares)
+	case len(parts) == 3 && parts[1] == "shares":
+		userID, err := strconv.ParseInt(parts[2], 10, 64)
+		if err != nil || userID <= 0 {
+			s.writeError(w, http.StatusNotFound, server.NewError(server.ErrUserID, "(%q)", parts[2]))
+			return
+		}
+		s.calendarShare(w, r, id, userID)
+	default:
+		s.writeError(w, http.StatusNotFound, server.NewError(server.ErrRequest, "(no route %v)", r.URL.Path))
+	}
+}
+
+func (s *Server) calendar(w http.ResponseWriter, r *http.Request, id int64) {
+	switch r.Method {
+	case http.MethodGet:
+		calendar, err := s.app.GetCalendar(r.Context(), id)
+		if err != nil {
+			s.writeError(w, statusCode(err), err)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, calendar)
+	case http.MethodPut, http.MethodPatch:
+		stored, err := s.app.GetCalendar(r.Context(), id)
+		if err != nil {
+			s.writeError(w, statusCode(err), err)
+			return
+		}
+		// PUT replaces the whole calendar, PATCH replaces the fields present in the body
+		calendar := model.Calendar{OwnerID: stored.OwnerID}
+		if r.Method == http.MethodPatch {
+			calendar = stored
+		}
+		if !s.decodeResource(w, r, &calendar) {
+			return
+		}
+		if calendar.ID != 0 && calendar.ID != id {
+			s.writeError(w, http.StatusUnprocessableEntity,
+				server.NewError(server.ErrID, "(id %v of the body differs from the path)", calendar.ID))
+			return
+		}
+		calendar.ID = id
+		if err := s.app.UpdateCalendar(r.Context(), &calendar); err != nil {
+			s.writeError(w, statusCode(err), err)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, calendar)
+	case http.MethodDelete:
+		if err := s.app.DeleteCalendar(r.Context(), id); err != nil {
+			s.writeError(w, statusCode(err), err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s.methodNotAllowed(w, http.MethodGet, http.MethodPut, http.MethodPatch, http.MethodDelete)
+	}
+}
+
+// calendarEvents lists the events of a calendar between from and to, or adds an event to it.
+func (s *Server) calendarEvents(w http.ResponseWriter, r *http.Request, id int64) {
+	switch r.Method {
+	case http.MethodGet:
+		s.listCalendarsEvents(w, r, []int64{id})
+	case http.MethodPost:
+		var event model.Event
+		if !s.decodeResource(w, r, &event) {
+			return
+		}
+		if event.CalendarID != 0 && event.CalendarID != id {
+			s.writeError(w, http.StatusUnprocessableEntity,
+				server.NewError(server.ErrCalendarNotFound, "(calendarid %v of the body differs from the path)",
+					event.CalendarID))
+			return
+		}
+		event.ID = 0
+		event.CalendarID = id
+
+		if err := s.app.InsertEvent(r.Context(), &event); err != nil {
+			s.writeError(w, statusCode(err), err)
+			return
+		}
+		w.Header().Set("Location", eventURL(event.ID))
+		w.Header().Set("ETag", eventETag(event.Version))
+		s.writeJSON(w, http.StatusCreated, event)
+	default:
+		s.methodNotAllowed(w, http.MethodGet, http.MethodPost)
+	}
+}
+
+// calendarShare grants (PUT with a permission body) or revokes (DELETE) the access of a user.
+func (s *Server) calendarShare(w http.ResponseWriter, r *http.Request, calendarID, userID int64) {
+	switch r.Method {
+	case http.MethodPut:
+		var share model.CalendarShare
+		if !s.decodeResource(w, r, &share) {
+			return
+		}
+		share.CalendarID = calendarID
+		share.UserID = userID
+		if err := s.app.ShareCalendar(r.Context(), &share); err != nil {
+			s.writeError(w, statusCode(err), err)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, share)
+	case http.MethodDelete:
+		if err := s.app.UnshareCalendar(r.Context(), calendarID, userID); err != nil {
+			s.writeError(w, statusCode(err), err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s.methodNotAllowed(w, http.MethodPut, http.MethodDelete)
+	}
+}
+
+// CalendarsEvents serves /events?calendars=1,2&from=...&to=..., the merged view of several calendars.
+func (s *Server) CalendarsEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		s.methodNotAllowed(w, http.MethodGet)
+		return
+	}
+	var ids []int64
+	for _, value := range strings.Split(r.URL.Query().Get("calendars"), ",") {
+		id, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil || id <= 0 {
+			s.writeError(w, http.StatusUnprocessableEntity,
+				server.NewError(server.ErrCalendarNotFound, "(%q in calendars)", value))
+			return
+		}
+		ids = append(ids, id)
+	}
+	s.listCalendarsEvents(w, r, ids)
+}
+
+func (s *Server) listCalendarsEvents(w http.ResponseWriter, r *http.Request, ids []int64) {
+	from, to, err := parseRange(r.URL.Query())
+	if err != nil {
+		s.writeError(w, statusCode(err), err)
+		return
+	}
+	events, err := s.app.GetCalendarsEvents(r.Context(), ids, from, to)
+	if err != nil {
+		s.writeError(w, statusCode(err), err)
+		return
+	}
+	s.writeJSON(w, http.StatusOK, events)
+}
@@ -0,0 +1,63 @@
+package internalhttp
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server"
+)
+
+const (
+	freeBusyPath = "/freebusy"
+	slotsPath    = "/freebusy/slots"
+)
+
+// FreeBusy serves /freebusy?users=1,2&from=...&to=..., the busy time of the users.
+func (s *Server) FreeBusy(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		s.methodNotAllowed(w, http.MethodGet)
+		return
+	}
+	query := r.URL.Query()
+	var userIDs []int64
+	for _, value := range strings.Split(query.Get("users"), ",") {
+		userID, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil || userID <= 0 {
+			s.writeError(w, http.StatusUnprocessableEntity, server.NewError(server.ErrUserID, "(%q in users)", value))
+			return
+		}
+		userIDs = append(userIDs, userID)
+	}
+	from, to, err := parseRange(query)
+	if err != nil {
+		s.writeError(w, statusCode(err), err)
+		return
+	}
+
+	fb, err := s.app.GetFreeBusy(r.Context(), userIDs, from, to)
+	if err != nil {
+		s.writeError(w, statusCode(err), err)
+		return
+	}
+	s.writeJSON(w, http.StatusOK, fb)
+}
+
+// Slots serves POST /freebusy/slots with a model.SlotQuery body and answers the proposed slots.
+func (s *Server) Slots(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		s.methodNotAllowed(w, http.MethodPost)
+		return
+	}
+	var query model.SlotQuery
+	if !s.decodeResource(w, r, &query) {
+		return
+	}
+	slots, err := s.app.FindSlots(r.Context(), query)
+	if err != nil {
+		s.writeError(w, statusCode(err), err)
+		return
+	}
+	s.writeJSON(w, http.StatusOK, slots)
+}
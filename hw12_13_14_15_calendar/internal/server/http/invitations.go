@@ -0,0 +1,87 @@
+package internalhttp
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server"
+)
+
+// eventAttendees lists the attendees of an event.
+func (s *Server) eventAttendees(w http.ResponseWriter, r *http.Request, id int64) {
+	if r.Method != http.MethodGet {
+		s.methodNotAllowed(w, http.MethodGet)
+		return
+	}
+	event, err := s.app.GetEventByID(r.Context(), id)
+	if err != nil {
+		s.writeError(w, statusCode(err), err)
+		return
+	}
+	attendees := event.Attendees
+	if attendees == nil {
+		attendees = []model.Attendee{}
+	}
+	s.writeJSON(w, http.StatusOK, attendees)
+}
+
+// eventAttendee invites (PUT) a user to an event or withdraws (DELETE) the invitation.
+func (s *Server) eventAttendee(w http.ResponseWriter, r *http.Request, id, userID int64) {
+	var err error
+	switch r.Method {
+	case http.MethodPut:
+		err = s.app.InviteAttendee(r.Context(), id, userID)
+	case http.MethodDelete:
+		err = s.app.RemoveAttendee(r.Context(), id, userID)
+	default:
+		s.methodNotAllowed(w, http.MethodPut, http.MethodDelete)
+		return
+	}
+	if err != nil {
+		s.writeError(w, statusCode(err), err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// invitations lists the invitations of a user (GET /users/{id}/invitations) or records
+// the response of the user (PUT /users/{id}/invitations/{eventid} with a status body).
+func (s *Server) invitations(w http.ResponseWriter, r *http.Request, userID int64, parts []string) {
+	switch len(parts) {
+	case 0:
+		if r.Method != http.MethodGet {
+			s.methodNotAllowed(w, http.MethodGet)
+			return
+		}
+		invitations, err := s.app.GetInvitations(r.Context(), userID)
+		if err != nil {
+			s.writeError(w, statusCode(err), err)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, invitations)
+	case 1:
+		eventID, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil || eventID <= 0 {
+			s.writeError(w, http.StatusNotFound, server.NewError(server.ErrEventNotFound, "(%q)", parts[0]))
+			return
+		}
+		if r.Method != http.MethodPut {
+			s.methodNotAllowed(w, http.MethodPut)
+			return
+		}
+		var response struct {
+			Status model.PartStat `json:"status"`
+		}
+		if !s.decodeResource(w, r, &response) {
+			return
+		}
+		if err := s.app.RespondInvitation(r.Context(), eventID, userID, response.Status); err != nil {
+			s.writeError(w, statusCode(err), err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s.writeError(w, http.StatusNotFound, server.NewError(server.ErrRequest, "(no route %v)", r.URL.Path))
+	}
+}
@@ -3,6 +3,9 @@ package internalhttp
 import (
 	"net/http"
 	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/auth"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server"
 )
 
 type statusWriter struct {
@@ -50,3 +53,22 @@ func (a *MiddlewareLogger) setCommonHeadersMiddleware(next http.Handler) http.Ha
 		next.ServeHTTP(w, r)
 	})
 }
+
+// AuthMiddleware puts the principal of the request credentials into the context.
+// Credentials are taken from the X-API-Key header or from an Authorization Bearer token.
+func (s *Server) AuthMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.authenticator == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		credential := auth.Credential(r.Header.Get("Authorization"), r.Header.Get("X-API-Key"))
+		p, err := s.authenticator.Authenticate(r.Context(), credential)
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="calendar"`)
+			s.writeError(w, http.StatusUnauthorized, server.NewError(server.ErrUnauthenticated, "(%v)", err))
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(auth.NewContext(r.Context(), p)))
+	})
+}
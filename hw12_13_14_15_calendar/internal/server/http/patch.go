@@ -0,0 +1,78 @@
+package internalhttp
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime"
+	"net/http"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server"
+)
+
+// mergePatchType is the media type of JSON Merge Patch, RFC 7396. Plain JSON bodies are merged the same way.
+const mergePatchType = "application/merge-patch+json"
+
+// mergePatch applies a JSON Merge Patch body to the stored event: members of the body replace the ones
+// of the event and null members clear them. The result is decoded as a whole event body into event.
+func (s *Server) mergePatch(w http.ResponseWriter, r *http.Request, stored model.Event, event *model.Event) bool {
+	if contentType := r.Header.Get("Content-Type"); contentType != "" {
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err != nil || (mediaType != mergePatchType && mediaType != "application/json") {
+			s.writeError(w, http.StatusUnsupportedMediaType,
+				server.NewError(server.ErrRequest, "(content type %q, want %v)", contentType, mergePatchType))
+			return false
+		}
+	}
+
+	var patch interface{}
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		s.writeError(w, http.StatusBadRequest, server.NewError(server.ErrRequest, "(can't decode json: %v)", err))
+		return false
+	}
+	if _, ok := patch.(map[string]interface{}); !ok {
+		s.writeError(w, http.StatusBadRequest, server.NewError(server.ErrRequest, "(merge patch is not an object)"))
+		return false
+	}
+
+	var target interface{}
+	data, err := json.Marshal(stored)
+	if err == nil {
+		err = json.Unmarshal(data, &target)
+	}
+	if err == nil {
+		data, err = json.Marshal(applyMergePatch(target, patch))
+	}
+	if err != nil {
+		s.writeError(w, http.StatusInternalServerError, err)
+		return false
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(event); err != nil {
+		s.writeError(w, http.StatusBadRequest, server.NewError(server.ErrRequest, "(can't apply merge patch: %v)", err))
+		return false
+	}
+	return true
+}
+
+// applyMergePatch is the MergePatch function of RFC 7396.
+func applyMergePatch(target, patch interface{}) interface{} {
+	members, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	result, ok := target.(map[string]interface{})
+	if !ok {
+		result = make(map[string]interface{}, len(members))
+	}
+	for name, value := range members {
+		if value == nil {
+			delete(result, name)
+			continue
+		}
+		result[name] = applyMergePatch(result[name], value)
+	}
+	return result
+}
@@ -0,0 +1,313 @@
+package internalhttp
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server"
+)
+
+// Resource routes of the REST API. The RPC-style routes registered in Start stay as they are.
+const (
+	usersPath     = "/users/"
+	eventsPath    = "/events/"
+	calendarsPath = "/calendars/"
+)
+
+func eventURL(id int64) string {
+	return eventsPath + strconv.FormatInt(id, 10)
+}
+
+func calendarURL(id int64) string {
+	return calendarsPath + strconv.FormatInt(id, 10)
+}
+
+// eventETag is the entity tag of a version of an event.
+func eventETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// ifMatch sets the version an update is conditional on from the If-Match header.
+// Without the header the version of the body is kept, "*" matches any version.
+// A weak tag never matches, a value that is not an ETag of an event is a bad request.
+func ifMatch(r *http.Request, event *model.Event) error {
+	value := strings.TrimSpace(r.Header.Get("If-Match"))
+	switch {
+	case value == "":
+		return nil
+	case value == "*":
+		event.Version = 0
+		return nil
+	case strings.HasPrefix(value, `W/"`):
+		return server.NewError(server.ErrVersionConflict, "(weak If-Match %q never matches)", value)
+	}
+	version, err := strconv.ParseInt(strings.Trim(value, `"`), 10, 64)
+	if err != nil || version <= 0 || value != eventETag(version) {
+		return server.NewError(server.ErrRequest, "(If-Match %q is not an event version)", value)
+	}
+	event.Version = version
+	return nil
+}
+
+// statusCode maps application errors to the status codes of the REST API.
+func statusCode(err error) int {
+	switch server.ToError(err).Code {
+	case server.CodeNotFound:
+		return http.StatusNotFound
+	case server.CodeConflict:
+		return http.StatusConflict
+	case server.CodePrecondition:
+		return http.StatusPreconditionFailed
+	case server.CodeInvalidArgument:
+		return http.StatusUnprocessableEntity
+	case server.CodeUnauthenticated:
+		return http.StatusUnauthorized
+	case server.CodePermission:
+		return http.StatusForbidden
+	}
+	return http.StatusInternalServerError
+}
+
+// decodeResource reads a JSON resource body, unknown fields are rejected as the whole body is the resource.
+func (s *Server) decodeResource(w http.ResponseWriter, r *http.Request, resource interface{}) bool {
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(resource); err != nil {
+		s.writeError(w, http.StatusBadRequest, server.NewError(server.ErrRequest, "(can't decode json: %v)", err))
+		return false
+	}
+	return true
+}
+
+// parseDate accepts RFC 3339 timestamps and plain dates.
+func parseDate(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, server.NewError(server.ErrRequest, "(%q is neither RFC 3339 nor a date)", value)
+	}
+	return t, nil
+}
+
+// parseRange reads the from and to query parameters.
+func parseRange(query url.Values) (from, to time.Time, err error) {
+	if from, err = parseDate(query.Get("from")); err != nil {
+		return from, to, err
+	}
+	to, err = parseDate(query.Get("to"))
+	return from, to, err
+}
+
+// Users serves /users/{id}/events, /users/{id}/calendars, /users/{id}/invitations,
+// /users/{id}/invitations/{eventid}, /users/{id}/trash and /users/{id}/trash/{eventid}.
+func (s *Server) Users(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, usersPath), "/")
+	userID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || userID <= 0 {
+		s.writeError(w, http.StatusNotFound, server.NewError(server.ErrUserID, "(%q)", parts[0]))
+		return
+	}
+	if len(parts) > 1 && parts[1] == "invitations" {
+		s.invitations(w, r, userID, parts[2:])
+		return
+	}
+	if len(parts) > 1 && parts[1] == "trash" {
+		s.trash(w, r, userID, parts[2:])
+		return
+	}
+	if len(parts) != 2 || (parts[1] != "events" && parts[1] != "calendars") {
+		s.writeError(w, http.StatusNotFound, server.NewError(server.ErrRequest, "(no route %v)", r.URL.Path))
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && parts[1] == "calendars":
+		s.listUserCalendars(w, r, userID)
+	case r.Method == http.MethodPost && parts[1] == "calendars":
+		s.createUserCalendar(w, r, userID)
+	case r.Method == http.MethodGet:
+		s.listUserEvents(w, r, userID)
+	case r.Method == http.MethodPost:
+		s.createUserEvent(w, r, userID)
+	default:
+		s.methodNotAllowed(w, http.MethodGet, http.MethodPost)
+	}
+}
+
+// listUserEvents answers all events of a user, or a range selected by query parameters:
+// from and to for an arbitrary range, or period (day, week, month) with date and optional timezone.
+func (s *Server) listUserEvents(w http.ResponseWriter, r *http.Request, userID int64) {
+	query := r.URL.Query()
+	var events []model.Event
+	var err error
+
+	switch {
+	case query.Has("from") || query.Has("to"):
+		var from, to time.Time
+		if from, to, err = parseRange(query); err != nil {
+			break
+		}
+		events, err = s.app.GetAllEventsRange(r.Context(), userID, from, to)
+	case query.Has("period"):
+		date := time.Now()
+		if query.Has("date") {
+			if date, err = parseDate(query.Get("date")); err != nil {
+				break
+			}
+		}
+		timeZone := query.Get("timezone")
+		switch query.Get("period") {
+		case "day":
+			events, err = s.app.GetAllEventsDay(r.Context(), userID, date, timeZone)
+		case "week":
+			events, err = s.app.GetAllEventsWeek(r.Context(), userID, date, timeZone)
+		case "month":
+			events, err = s.app.GetAllEventsMonth(r.Context(), userID, date, timeZone)
+		default:
+			err = server.NewError(server.ErrRequest, "(period must be day, week or month)")
+		}
+	default:
+		events, err = s.app.GetAllEvents(r.Context(), userID)
+	}
+	if err != nil {
+		s.writeError(w, statusCode(err), err)
+		return
+	}
+	s.writeJSON(w, http.StatusOK, events)
+}
+
+func (s *Server) createUserEvent(w http.ResponseWriter, r *http.Request, userID int64) {
+	var event model.Event
+	if !s.decodeResource(w, r, &event) {
+		return
+	}
+	if event.UserID != 0 && event.UserID != userID {
+		s.writeError(w, http.StatusUnprocessableEntity,
+			server.NewError(server.ErrUserID, "(userid %v of the body differs from the path)", event.UserID))
+		return
+	}
+	event.ID = 0
+	event.UserID = userID
+
+	if err := s.app.InsertEvent(r.Context(), &event); err != nil {
+		s.writeError(w, statusCode(err), err)
+		return
+	}
+	w.Header().Set("Location", eventURL(event.ID))
+	w.Header().Set("ETag", eventETag(event.Version))
+	s.writeJSON(w, http.StatusCreated, event)
+}
+
+// Events serves /events/{id}, /events/{id}/history, /events/{id}/attendees and /events/{id}/attendees/{userid}.
+func (s *Server) Events(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, eventsPath), "/")
+	id, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || id <= 0 {
+		s.writeError(w, http.StatusNotFound, server.NewError(server.ErrEventNotFound, "(%q)", r.URL.Path))
+		return
+	}
+
+	switch {
+	case len(parts) == 1:
+		s.event(w, r, id)
+	case len(parts) == 2 && parts[1] == "history":
+		s.eventHistory(w, r, id)
+	case len(parts) == 2 && parts[1] == "attendees":
+		s.eventAttendees(w, r, id)
+	case len(parts) == 3 && parts[1] == "attendees":
+		userID, err := strconv.ParseInt(parts[2], 10, 64)
+		if err != nil || userID <= 0 {
+			s.writeError(w, http.StatusNotFound, server.NewError(server.ErrUserID, "(%q)", parts[2]))
+			return
+		}
+		s.eventAttendee(w, r, id, userID)
+	default:
+		s.writeError(w, http.StatusNotFound, server.NewError(server.ErrRequest, "(no route %v)", r.URL.Path))
+	}
+}
+
+func (s *Server) event(w http.ResponseWriter, r *http.Request, id int64) {
+	switch r.Method {
+	case http.MethodGet:
+		event, err := s.app.GetEventByID(r.Context(), id)
+		if err != nil {
+			s.writeError(w, statusCode(err), err)
+			return
+		}
+		w.Header().Set("ETag", eventETag(event.Version))
+		s.writeJSON(w, http.StatusOK, event)
+	case http.MethodPut, http.MethodPatch:
+		stored, err := s.app.GetEventByID(r.Context(), id)
+		if err != nil {
+			s.writeError(w, statusCode(err), err)
+			return
+		}
+		// PUT replaces the whole event, PATCH merges the body into it
+		event := model.Event{UserID: stored.UserID}
+		if r.Method == http.MethodPatch {
+			if !s.mergePatch(w, r, stored, &event) {
+				return
+			}
+		} else if !s.decodeResource(w, r, &event) {
+			return
+		}
+		if err := ifMatch(r, &event); err != nil {
+			if errors.Is(err, server.ErrRequest) {
+				s.writeError(w, http.StatusBadRequest, err)
+			} else {
+				s.writeError(w, statusCode(err), err)
+			}
+			return
+		}
+		s.updateEvent(w, r, &stored, &event)
+	case http.MethodDelete:
+		if err := s.app.DeleteEvent(r.Context(), id); err != nil {
+			s.writeError(w, statusCode(err), err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s.methodNotAllowed(w, http.MethodGet, http.MethodPut, http.MethodPatch, http.MethodDelete)
+	}
+}
+
+func (s *Server) eventHistory(w http.ResponseWriter, r *http.Request, id int64) {
+	if r.Method != http.MethodGet {
+		s.methodNotAllowed(w, http.MethodGet)
+		return
+	}
+	entries, err := s.app.GetEventHistory(r.Context(), id)
+	if err != nil {
+		s.writeError(w, statusCode(err), err)
+		return
+	}
+	s.writeJSON(w, http.StatusOK, entries)
+}
+
+func (s *Server) updateEvent(w http.ResponseWriter, r *http.Request, stored, event *model.Event) {
+	switch {
+	case event.ID != 0 && event.ID != stored.ID:
+		s.writeError(w, http.StatusUnprocessableEntity,
+			server.NewError(server.ErrID, "(id %v of the body differs from the path)", event.ID))
+		return
+	case event.UserID != stored.UserID:
+		s.writeError(w, http.StatusUnprocessableEntity,
+			server.NewError(server.ErrUserID, "(owner of an event can't be changed)"))
+		return
+	}
+	event.ID = stored.ID
+	if err := s.app.UpdateEvent(r.Context(), event); err != nil {
+		s.writeError(w, statusCode(err), err)
+		return
+	}
+	w.Header().Set("ETag", eventETag(event.Version))
+	s.writeJSON(w, http.StatusOK, event)
+}
@@ -1,14 +1,19 @@
 package internalhttp
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/auth"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/ical"
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server"
 )
@@ -20,11 +25,12 @@ const (
 )
 
 type Server struct {
-	srv  http.Server
-	app  server.Application
-	log  Logger
-	host string
-	port string
+	srv           http.Server
+	app           server.Application
+	authenticator auth.Authenticator
+	log           Logger
+	host          string
+	port          string
 }
 
 type Logger interface {
@@ -44,25 +50,76 @@ type reqByUser struct {
 }
 
 type reqByUserByDate struct {
-	UserID int64     `json:"userid"`
-	Date   time.Time `json:"date"`
+	UserID   int64     `json:"userid"`
+	Date     time.Time `json:"date"`
+	TimeZone string    `json:"timezone"`
+}
+
+type reqFeedToken struct {
+	UserID int64  `json:"userid"`
+	Token  string `json:"token"`
+}
+
+type repFeedToken struct {
+	model.FeedToken
+	URL string `json:"url"`
+}
+
+const feedsPath = "/feeds/"
+
+func feedURL(token string) string {
+	return feedsPath + token + ".ics"
+}
+
+// errorBody is the JSON error of every route, error keeps the human-readable text of older clients.
+type errorBody struct {
+	Error string      `json:"error"`
+	Code  server.Code `json:"code"`
+	Field string      `json:"field,omitempty"`
 }
 
 func NewServer(log Logger, app server.Application, host, port string) *Server {
 	return &Server{log: log, app: app, host: host, port: port}
 }
 
+// SetAuthenticator enables authentication of API routes, nil disables it.
+func (s *Server) SetAuthenticator(a auth.Authenticator) {
+	s.authenticator = a
+}
+
 func (s *Server) helperDecode(stream io.ReadCloser, w http.ResponseWriter, data interface{}) error {
 	decoder := json.NewDecoder(stream)
 	if err := decoder.Decode(&data); err != nil {
 		s.log.Errorf("Can't decode json:%v\n", err)
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write([]byte(fmt.Sprintf("{\"error\": \"Can't decode json:%v\"}\n", err)))
+		s.writeError(w, http.StatusBadRequest, server.NewError(server.ErrRequest, "(can't decode json: %v)", err))
 		return err
 	}
 	return nil
 }
 
+func (s *Server) writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	rawJSON, err := json.Marshal(data)
+	if err != nil {
+		s.log.Errorf("Can't marshal json:%v\n", err)
+		status, rawJSON = http.StatusInternalServerError, []byte(`{"error": "can't marshal json", "code": "internal"}`)
+	}
+	w.WriteHeader(status)
+	w.Write(rawJSON)
+}
+
+func (s *Server) writeError(w http.ResponseWriter, status int, err error) {
+	e := server.ToError(err)
+	if e.Code == server.CodeInternal {
+		s.log.Errorf("Internal error:%v\n", err)
+	}
+	s.writeJSON(w, status, errorBody{Error: e.Message, Code: e.Code, Field: e.Field})
+}
+
+func (s *Server) methodNotAllowed(w http.ResponseWriter, allow ...string) {
+	w.Header().Set("Allow", strings.Join(allow, ", "))
+	s.writeError(w, http.StatusMethodNotAllowed, server.NewError(server.ErrRequest, "(method not allowed)"))
+}
+
 func (s *Server) InsertEvent(w http.ResponseWriter, r *http.Request) {
 	var event model.Event
 	if err := s.helperDecode(r.Body, w, &event); err != nil {
@@ -72,8 +129,7 @@ func (s *Server) InsertEvent(w http.ResponseWriter, r *http.Request) {
 	err := s.app.InsertEvent(r.Context(), &event)
 	if err != nil {
 		s.log.Errorf("InsertEvent:%v\n", err)
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write([]byte(fmt.Sprintf("{\"error\": \"Can't InsertEvent:%v\"}\n", err)))
+		s.writeError(w, http.StatusBadRequest, err)
 		return
 	}
 	w.WriteHeader(http.StatusOK)
@@ -87,8 +143,7 @@ func (s *Server) UpdateEvent(w http.ResponseWriter, r *http.Request) {
 	}
 	if err := s.app.UpdateEvent(r.Context(), &event); err != nil {
 		s.log.Errorf("Can't update event:%v\n", err)
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write([]byte(fmt.Sprintf("{\"error\": \"Can't update event:%v\"}\n", err)))
+		s.writeError(w, http.StatusBadRequest, err)
 		return
 	}
 	w.WriteHeader(http.StatusOK)
@@ -102,8 +157,7 @@ func (s *Server) DeleteEvent(w http.ResponseWriter, r *http.Request) {
 	}
 	if err := s.app.DeleteEvent(r.Context(), req.ID); err != nil {
 		s.log.Errorf("Can't delete event:%v\n", err)
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write([]byte(fmt.Sprintf("{\"error\": \"Can't delete event:%v\"}\n", err)))
+		s.writeError(w, http.StatusBadRequest, err)
 		return
 	}
 	w.WriteHeader(http.StatusOK)
@@ -118,15 +172,13 @@ func (s *Server) GetEventByID(w http.ResponseWriter, r *http.Request) { //nolint
 	event, err := s.app.GetEventByID(r.Context(), req.ID)
 	if err != nil {
 		s.log.Errorf("Can't get event by id:%v\n", err)
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write([]byte(fmt.Sprintf("{\"error\": \"Can't get event by id:%v\"}\n", err)))
+		s.writeError(w, http.StatusBadRequest, err)
 		return
 	}
 	rawJSON, err := json.Marshal(event)
 	if err != nil {
 		s.log.Errorf("Can't marshal event:%v\n", err)
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write([]byte(fmt.Sprintf("{\"error\": \"Can't marshal event:%v\"}\n", err)))
+		s.writeError(w, http.StatusInternalServerError, err)
 		return
 	}
 	w.WriteHeader(http.StatusOK)
@@ -141,15 +193,13 @@ func (s *Server) GetAllEvents(w http.ResponseWriter, r *http.Request) { //nolint
 	events, err := s.app.GetAllEvents(r.Context(), req.UserID)
 	if err != nil {
 		s.log.Errorf("Can't get all events:%v\n", err)
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write([]byte(fmt.Sprintf("{\"error\": \"Can't get all events:%v\"}\n", err)))
+		s.writeError(w, http.StatusBadRequest, err)
 		return
 	}
 	rawJSON, err := json.Marshal(events)
 	if err != nil {
 		s.log.Errorf("Can't marshal events:%v\n", err)
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write([]byte(fmt.Sprintf("{\"error\": \"Can't marshal events:%v\"}\n", err)))
+		s.writeError(w, http.StatusInternalServerError, err)
 		return
 	}
 	w.WriteHeader(http.StatusOK)
@@ -161,18 +211,16 @@ func (s *Server) GetAllEventsDay(w http.ResponseWriter, r *http.Request) { //nol
 	if err := s.helperDecode(r.Body, w, &req); err != nil {
 		return
 	}
-	events, err := s.app.GetAllEventsDay(r.Context(), req.UserID, req.Date)
+	events, err := s.app.GetAllEventsDay(r.Context(), req.UserID, req.Date, req.TimeZone)
 	if err != nil {
 		s.log.Errorf("Can't get all events:%v\n", err)
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write([]byte(fmt.Sprintf("{\"error\": \"Can't get all events:%v\"}\n", err)))
+		s.writeError(w, http.StatusBadRequest, err)
 		return
 	}
 	rawJSON, err := json.Marshal(events)
 	if err != nil {
 		s.log.Errorf("Can't marshal events:%v\n", err)
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write([]byte(fmt.Sprintf("{\"error\": \"Can't marshal events:%v\"}\n", err)))
+		s.writeError(w, http.StatusInternalServerError, err)
 		return
 	}
 	w.WriteHeader(http.StatusOK)
@@ -184,18 +232,16 @@ func (s *Server) GetAllEventsWeek(w http.ResponseWriter, r *http.Request) { //no
 	if err := s.helperDecode(r.Body, w, &req); err != nil {
 		return
 	}
-	events, err := s.app.GetAllEventsWeek(r.Context(), req.UserID, req.Date)
+	events, err := s.app.GetAllEventsWeek(r.Context(), req.UserID, req.Date, req.TimeZone)
 	if err != nil {
 		s.log.Errorf("Can't get all events:%v\n", err)
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write([]byte(fmt.Sprintf("{\"error\": \"Can't get all events:%v\"}\n", err)))
+		s.writeError(w, http.StatusBadRequest, err)
 		return
 	}
 	rawJSON, err := json.Marshal(events)
 	if err != nil {
 		s.log.Errorf("Can't marshal events:%v\n", err)
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write([]byte(fmt.Sprintf("{\"error\": \"Can't marshal events:%v\"}\n", err)))
+		s.writeError(w, http.StatusInternalServerError, err)
 		return
 	}
 	w.WriteHeader(http.StatusOK)
@@ -207,24 +253,192 @@ func (s *Server) GetAllEventsMonth(w http.ResponseWriter, r *http.Request) { //n
 	if err := s.helperDecode(r.Body, w, &req); err != nil {
 		return
 	}
-	events, err := s.app.GetAllEventsMonth(r.Context(), req.UserID, req.Date)
+	events, err := s.app.GetAllEventsMonth(r.Context(), req.UserID, req.Date, req.TimeZone)
 	if err != nil {
 		s.log.Errorf("Can't get all events:%v\n", err)
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write([]byte(fmt.Sprintf("{\"error\": \"Can't get all events:%v\"}\n", err)))
+		s.writeError(w, http.StatusBadRequest, err)
 		return
 	}
 	rawJSON, err := json.Marshal(events)
 	if err != nil {
 		s.log.Errorf("Can't marshal events:%v\n", err)
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write([]byte(fmt.Sprintf("{\"error\": \"Can't marshal events:%v\"}\n", err)))
+		s.writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(rawJSON)
+}
+
+func (s *Server) GetUser(w http.ResponseWriter, r *http.Request) { //nolint:dupl
+	var req reqByUser
+	if err := s.helperDecode(r.Body, w, &req); err != nil {
+		return
+	}
+	user, err := s.app.GetUser(r.Context(), req.UserID)
+	if err != nil {
+		s.log.Errorf("Can't get user:%v\n", err)
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	rawJSON, err := json.Marshal(user)
+	if err != nil {
+		s.log.Errorf("Can't marshal user:%v\n", err)
+		s.writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(rawJSON)
+}
+
+func (s *Server) UpdateUser(w http.ResponseWriter, r *http.Request) {
+	var user model.User
+	if err := s.helperDecode(r.Body, w, &user); err != nil {
+		return
+	}
+	if err := s.app.UpdateUser(r.Context(), &user); err != nil {
+		s.log.Errorf("Can't update user:%v\n", err)
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("{\"msg\": \"Updated\"}\n"))
+}
+
+// helperUserID reads the userid query parameter of requests whose body is not JSON.
+func (s *Server) helperUserID(w http.ResponseWriter, r *http.Request) (int64, error) {
+	userID, err := strconv.ParseInt(r.URL.Query().Get("userid"), 10, 64)
+	if err != nil {
+		s.log.Errorf("Can't parse userid:%v\n", err)
+		s.writeError(w, http.StatusBadRequest, server.NewError(server.ErrUserID, "(%v)", err))
+		return 0, err
+	}
+	return userID, nil
+}
+
+// ImportEvents reads a text/calendar body and answers with the per-event import report.
+func (s *Server) ImportEvents(w http.ResponseWriter, r *http.Request) {
+	userID, err := s.helperUserID(w, r)
+	if err != nil {
+		return
+	}
+	report, err := s.app.ImportEvents(r.Context(), userID, r.Body)
+	if err != nil {
+		s.log.Errorf("Can't import events:%v\n", err)
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	rawJSON, err := json.Marshal(report)
+	if err != nil {
+		s.log.Errorf("Can't marshal report:%v\n", err)
+		s.writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(rawJSON)
+}
+
+func (s *Server) ExportEvents(w http.ResponseWriter, r *http.Request) {
+	userID, err := s.helperUserID(w, r)
+	if err != nil {
+		return
+	}
+	var buf bytes.Buffer
+	if err := s.app.ExportEvents(r.Context(), userID, &buf); err != nil {
+		s.log.Errorf("Can't export events:%v\n", err)
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	w.Header().Set("Content-Type", ical.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"calendar-%d.ics\"", userID))
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
+func (s *Server) CreateFeedToken(w http.ResponseWriter, r *http.Request) {
+	var req reqByUser
+	if err := s.helperDecode(r.Body, w, &req); err != nil {
+		return
+	}
+	token, err := s.app.CreateFeedToken(r.Context(), req.UserID)
+	if err != nil {
+		s.log.Errorf("Can't create feed token:%v\n", err)
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	rawJSON, err := json.Marshal(repFeedToken{FeedToken: token, URL: feedURL(token.Token)})
+	if err != nil {
+		s.log.Errorf("Can't marshal feed token:%v\n", err)
+		s.writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(rawJSON)
+}
+
+func (s *Server) GetFeedTokens(w http.ResponseWriter, r *http.Request) {
+	var req reqByUser
+	if err := s.helperDecode(r.Body, w, &req); err != nil {
+		return
+	}
+	tokens, err := s.app.GetFeedTokens(r.Context(), req.UserID)
+	if err != nil {
+		s.log.Errorf("Can't get feed tokens:%v\n", err)
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	rep := make([]repFeedToken, len(tokens))
+	for i, token := range tokens {
+		rep[i] = repFeedToken{FeedToken: token, URL: feedURL(token.Token)}
+	}
+	rawJSON, err := json.Marshal(rep)
+	if err != nil {
+		s.log.Errorf("Can't marshal feed tokens:%v\n", err)
+		s.writeError(w, http.StatusInternalServerError, err)
 		return
 	}
 	w.WriteHeader(http.StatusOK)
 	w.Write(rawJSON)
 }
 
+func (s *Server) RevokeFeedToken(w http.ResponseWriter, r *http.Request) {
+	var req reqFeedToken
+	if err := s.helperDecode(r.Body, w, &req); err != nil {
+		return
+	}
+	if err := s.app.RevokeFeedToken(r.Context(), req.UserID, req.Token); err != nil {
+		s.log.Errorf("Can't revoke feed token:%v\n", err)
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("{\"msg\": \"Revoked\"}\n"))
+}
+
+// Feed serves /feeds/{token}.ics for calendar clients, conditional requests are answered by http.ServeContent.
+func (s *Server) Feed(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		s.methodNotAllowed(w, http.MethodGet, http.MethodHead)
+		return
+	}
+	name := strings.TrimPrefix(r.URL.Path, feedsPath)
+	token := strings.TrimSuffix(name, ".ics")
+	if token == name || token == "" || strings.Contains(token, "/") {
+		s.writeError(w, http.StatusNotFound, server.NewError(server.ErrFeedToken, "(%q)", name))
+		return
+	}
+
+	feed, err := s.app.GetFeed(r.Context(), token)
+	if err != nil {
+		s.log.Errorf("Can't get feed:%v\n", err)
+		s.writeError(w, statusCode(err), err)
+		return
+	}
+	w.Header().Set("Content-Type", ical.ContentType)
+	w.Header().Set("ETag", feed.ETag)
+	w.Header().Set("Cache-Control", "private, no-cache")
+	http.ServeContent(w, r, name, feed.Modified, bytes.NewReader(feed.Data))
+}
+
 func (s *Server) Start(ctx context.Context) error {
 	addr := net.JoinHostPort(s.host, s.port)
 	midLogger := NewMiddlewareLogger()
@@ -243,21 +457,50 @@ func (s *Server) Start(ctx context.Context) error {
 		}))))
 
 	mux.Handle("/InsertEvent", midLogger.setCommonHeadersMiddleware(
-		midLogger.loggingMiddleware(http.HandlerFunc(s.InsertEvent))))
+		midLogger.loggingMiddleware(s.AuthMiddleware(http.HandlerFunc(s.InsertEvent)))))
 	mux.Handle("/UpdateEvent", midLogger.setCommonHeadersMiddleware(
-		midLogger.loggingMiddleware(http.HandlerFunc(s.UpdateEvent))))
+		midLogger.loggingMiddleware(s.AuthMiddleware(http.HandlerFunc(s.UpdateEvent)))))
 	mux.Handle("/DeleteEvent", midLogger.setCommonHeadersMiddleware(
-		midLogger.loggingMiddleware(http.HandlerFunc(s.DeleteEvent))))
+		midLogger.loggingMiddleware(s.AuthMiddleware(http.HandlerFunc(s.DeleteEvent)))))
 	mux.Handle("/GetEventByID", midLogger.setCommonHeadersMiddleware(
-		midLogger.loggingMiddleware(http.HandlerFunc(s.GetEventByID))))
+		midLogger.loggingMiddleware(s.AuthMiddleware(http.HandlerFunc(s.GetEventByID)))))
 	mux.Handle("/GetAllEvents", midLogger.setCommonHeadersMiddleware(
-		midLogger.loggingMiddleware(http.HandlerFunc(s.GetAllEvents))))
+		midLogger.loggingMiddleware(s.AuthMiddleware(http.HandlerFunc(s.GetAllEvents)))))
 	mux.Handle("/GetAllEventsDay", midLogger.setCommonHeadersMiddleware(
-		midLogger.loggingMiddleware(http.HandlerFunc(s.GetAllEventsDay))))
+		midLogger.loggingMiddleware(s.AuthMiddleware(http.HandlerFunc(s.GetAllEventsDay)))))
 	mux.Handle("/GetAllEventsWeek", midLogger.setCommonHeadersMiddleware(
-		midLogger.loggingMiddleware(http.HandlerFunc(s.GetAllEventsWeek))))
+		midLogger.loggingMiddleware(s.AuthMiddleware(http.HandlerFunc(s.GetAllEventsWeek)))))
 	mux.Handle("/GetAllEventsMonth", midLogger.setCommonHeadersMiddleware(
-		midLogger.loggingMiddleware(http.HandlerFunc(s.GetAllEventsMonth))))
+		midLogger.loggingMiddleware(s.AuthMiddleware(http.HandlerFunc(s.GetAllEventsMonth)))))
+	mux.Handle("/GetUser", midLogger.setCommonHeadersMiddleware(
+		midLogger.loggingMiddleware(s.AuthMiddleware(http.HandlerFunc(s.GetUser)))))
+	mux.Handle("/UpdateUser", midLogger.setCommonHeadersMiddleware(
+		midLogger.loggingMiddleware(s.AuthMiddleware(http.HandlerFunc(s.UpdateUser)))))
+	mux.Handle("/ImportEvents", midLogger.setCommonHeadersMiddleware(
+		midLogger.loggingMiddleware(s.AuthMiddleware(http.HandlerFunc(s.ImportEvents)))))
+	mux.Handle("/ExportEvents", midLogger.setCommonHeadersMiddleware(
+		midLogger.loggingMiddleware(s.AuthMiddleware(http.HandlerFunc(s.ExportEvents)))))
+	mux.Handle("/CreateFeedToken", midLogger.setCommonHeadersMiddleware(
+		midLogger.loggingMiddleware(s.AuthMiddleware(http.HandlerFunc(s.CreateFeedToken)))))
+	mux.Handle("/GetFeedTokens", midLogger.setCommonHeadersMiddleware(
+		midLogger.loggingMiddleware(s.AuthMiddleware(http.HandlerFunc(s.GetFeedTokens)))))
+	mux.Handle("/RevokeFeedToken", midLogger.setCommonHeadersMiddleware(
+		midLogger.loggingMiddleware(s.AuthMiddleware(http.HandlerFunc(s.RevokeFeedToken)))))
+	mux.Handle(feedsPath, midLogger.setCommonHeadersMiddleware(
+		midLogger.loggingMiddleware(http.HandlerFunc(s.Feed))))
+
+	mux.Handle(usersPath, midLogger.setCommonHeadersMiddleware(
+		midLogger.loggingMiddleware(s.AuthMiddleware(http.HandlerFunc(s.Users)))))
+	mux.Handle(eventsPath, midLogger.setCommonHeadersMiddleware(
+		midLogger.loggingMiddleware(s.AuthMiddleware(http.HandlerFunc(s.Events)))))
+	mux.Handle(strings.TrimSuffix(eventsPath, "/"), midLogger.setCommonHeadersMiddleware(
+		midLogger.loggingMiddleware(s.AuthMiddleware(http.HandlerFunc(s.CalendarsEvents)))))
+	mux.Handle(calendarsPath, midLogger.setCommonHeadersMiddleware(
+		midLogger.loggingMiddleware(s.AuthMiddleware(http.HandlerFunc(s.Calendars)))))
+	mux.Handle(freeBusyPath, midLogger.setCommonHeadersMiddleware(
+		midLogger.loggingMiddleware(s.AuthMiddleware(http.HandlerFunc(s.FreeBusy)))))
+	mux.Handle(slotsPath, midLogger.setCommonHeadersMiddleware(
+		midLogger.loggingMiddleware(s.AuthMiddleware(http.HandlerFunc(s.Slots)))))
 
 	s.srv = http.Server{
 		Addr:              addr,
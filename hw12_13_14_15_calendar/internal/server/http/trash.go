@@ -0,0 +1,46 @@
+package internalhttp
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/server"
+)
+
+// trash lists the deleted events of a user (GET /users/{id}/trash) or restores
+// one of them (POST /users/{id}/trash/{eventid}).
+func (s *Server) trash(w http.ResponseWriter, r *http.Request, userID int64, parts []string) {
+	switch len(parts) {
+	case 0:
+		if r.Method != http.MethodGet {
+			s.methodNotAllowed(w, http.MethodGet)
+			return
+		}
+		events, err := s.app.GetTrash(r.Context(), userID)
+		if err != nil {
+			s.writeError(w, statusCode(err), err)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, events)
+	case 1:
+		eventID, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil || eventID <= 0 {
+			s.writeError(w, http.StatusNotFound, server.NewError(server.ErrEventNotFound, "(%q)", parts[0]))
+			return
+		}
+		if r.Method != http.MethodPost {
+			s.methodNotAllowed(w, http.MethodPost)
+			return
+		}
+		event, err := s.app.RestoreEvent(r.Context(), userID, eventID)
+		if err != nil {
+			s.writeError(w, statusCode(err), err)
+			return
+		}
+		w.Header().Set("Location", eventURL(event.ID))
+		w.Header().Set("ETag", eventETag(event.Version))
+		s.writeJSON(w, http.StatusOK, event)
+	default:
+		s.writeError(w, http.StatusNotFound, server.NewError(server.ErrRequest, "(no route %v)", r.URL.Path))
+	}
+}
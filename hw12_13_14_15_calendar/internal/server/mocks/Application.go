@@ -8,6 +8,7 @@ import (
 	model "github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
 	mock "github.com/stretchr/testify/mock"
 
+	io "io"
 	time "time"
 )
 
@@ -16,6 +17,70 @@ type Application struct {
 	mock.Mock
 }
 
+// CreateCalendar provides a mock function with given fields: _a0, _a1
+func (_m *Application) CreateCalendar(_a0 context.Context, _a1 *model.Calendar) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCalendar")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Calendar) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateFeedToken provides a mock function with given fields: _a0, _a1
+func (_m *Application) CreateFeedToken(_a0 context.Context, _a1 int64) (model.FeedToken, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFeedToken")
+	}
+
+	var r0 model.FeedToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (model.FeedToken, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) model.FeedToken); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(model.FeedToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteCalendar provides a mock function with given fields: _a0, _a1
+func (_m *Application) DeleteCalendar(_a0 context.Context, _a1 int64) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCalendar")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteEvent provides a mock function with given fields: _a0, _a1
 func (_m *Application) DeleteEvent(_a0 context.Context, _a1 int64) error {
 	ret := _m.Called(_a0, _a1)
@@ -34,6 +99,54 @@ func (_m *Application) DeleteEvent(_a0 context.Context, _a1 int64) error {
 	return r0
 }
 
+// ExportEvents provides a mock function with given fields: _a0, _a1, _a2
+func (_m *Application) ExportEvents(_a0 context.Context, _a1 int64, _a2 io.Writer) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, io.Writer) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindSlots provides a mock function with given fields: _a0, _a1
+func (_m *Application) FindSlots(_a0 context.Context, _a1 model.SlotQuery) ([]model.Interval, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSlots")
+	}
+
+	var r0 []model.Interval
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.SlotQuery) ([]model.Interval, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.SlotQuery) []model.Interval); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Interval)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.SlotQuery) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllEvents provides a mock function with given fields: _a0, _a1
 func (_m *Application) GetAllEvents(_a0 context.Context, _a1 int64) ([]model.Event, error) {
 	ret := _m.Called(_a0, _a1)
@@ -64,9 +177,9 @@ func (_m *Application) GetAllEvents(_a0 context.Context, _a1 int64) ([]model.Eve
 	return r0, r1
 }
 
-// GetAllEventsDay provides a mock function with given fields: _a0, _a1, _a2
-func (_m *Application) GetAllEventsDay(_a0 context.Context, _a1 int64, _a2 time.Time) ([]model.Event, error) {
-	ret := _m.Called(_a0, _a1, _a2)
+// GetAllEventsDay provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *Application) GetAllEventsDay(_a0 context.Context, _a1 int64, _a2 time.Time, _a3 string) ([]model.Event, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAllEventsDay")
@@ -74,19 +187,19 @@ func (_m *Application) GetAllEventsDay(_a0 context.Context, _a1 int64, _a2 time.
 
 	var r0 []model.Event
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) ([]model.Event, error)); ok {
-		return rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, string) ([]model.Event, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) []model.Event); ok {
-		r0 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, string) []model.Event); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Event)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
-		r1 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -94,9 +207,9 @@ func (_m *Application) GetAllEventsDay(_a0 context.Context, _a1 int64, _a2 time.
 	return r0, r1
 }
 
-// GetAllEventsMonth provides a mock function with given fields: _a0, _a1, _a2
-func (_m *Application) GetAllEventsMonth(_a0 context.Context, _a1 int64, _a2 time.Time) ([]model.Event, error) {
-	ret := _m.Called(_a0, _a1, _a2)
+// GetAllEventsMonth provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *Application) GetAllEventsMonth(_a0 context.Context, _a1 int64, _a2 time.Time, _a3 string) ([]model.Event, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAllEventsMonth")
@@ -104,19 +217,19 @@ func (_m *Application) GetAllEventsMonth(_a0 context.Context, _a1 int64, _a2 tim
 
 	var r0 []model.Event
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) ([]model.Event, error)); ok {
-		return rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, string) ([]model.Event, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) []model.Event); ok {
-		r0 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, string) []model.Event); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Event)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
-		r1 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -124,9 +237,39 @@ func (_m *Application) GetAllEventsMonth(_a0 context.Context, _a1 int64, _a2 tim
 	return r0, r1
 }
 
-// GetAllEventsWeek provides a mock function with given fields: _a0, _a1, _a2
-func (_m *Application) GetAllEventsWeek(_a0 context.Context, _a1 int64, _a2 time.Time) ([]model.Event, error) {
-	ret := _m.Called(_a0, _a1, _a2)
+// GetAllEventsRange provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *Application) GetAllEventsRange(_a0 context.Context, _a1 int64, _a2 time.Time, _a3 time.Time) ([]model.Event, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllEventsRange")
+	}
+
+	var r0 []model.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) ([]model.Event, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) []model.Event); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllEventsWeek provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *Application) GetAllEventsWeek(_a0 context.Context, _a1 int64, _a2 time.Time, _a3 string) ([]model.Event, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAllEventsWeek")
@@ -134,19 +277,137 @@ func (_m *Application) GetAllEventsWeek(_a0 context.Context, _a1 int64, _a2 time
 
 	var r0 []model.Event
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) ([]model.Event, error)); ok {
-		return rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, string) ([]model.Event, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) []model.Event); ok {
-		r0 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, string) []model.Event); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Event)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
-		r1 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCalendar provides a mock function with given fields: _a0, _a1
+func (_m *Application) GetCalendar(_a0 context.Context, _a1 int64) (model.Calendar, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCalendar")
+	}
+
+	var r0 model.Calendar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (model.Calendar, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) model.Calendar); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(model.Calendar)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCalendarShares provides a mock function with given fields: _a0, _a1
+func (_m *Application) GetCalendarShares(_a0 context.Context, _a1 int64) ([]model.CalendarShare, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCalendarShares")
+	}
+
+	var r0 []model.CalendarShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]model.CalendarShare, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []model.CalendarShare); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.CalendarShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCalendars provides a mock function with given fields: _a0, _a1
+func (_m *Application) GetCalendars(_a0 context.Context, _a1 int64) ([]model.Calendar, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCalendars")
+	}
+
+	var r0 []model.Calendar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]model.Calendar, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []model.Calendar); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Calendar)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCalendarsEvents provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *Application) GetCalendarsEvents(_a0 context.Context, _a1 []int64, _a2 time.Time, _a3 time.Time) ([]model.Event, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCalendarsEvents")
+	}
+
+	var r0 []model.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, time.Time, time.Time) ([]model.Event, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, time.Time, time.Time) []model.Event); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64, time.Time, time.Time) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -182,6 +443,238 @@ func (_m *Application) GetEventByID(_a0 context.Context, _a1 int64) (model.Event
 	return r0, r1
 }
 
+// GetEventHistory provides a mock function with given fields: _a0, _a1
+func (_m *Application) GetEventHistory(_a0 context.Context, _a1 int64) ([]model.AuditEntry, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEventHistory")
+	}
+
+	var r0 []model.AuditEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]model.AuditEntry, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []model.AuditEntry); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.AuditEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFeed provides a mock function with given fields: _a0, _a1
+func (_m *Application) GetFeed(_a0 context.Context, _a1 string) (model.Feed, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeed")
+	}
+
+	var r0 model.Feed
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.Feed, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.Feed); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(model.Feed)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFeedTokens provides a mock function with given fields: _a0, _a1
+func (_m *Application) GetFeedTokens(_a0 context.Context, _a1 int64) ([]model.FeedToken, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeedTokens")
+	}
+
+	var r0 []model.FeedToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]model.FeedToken, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []model.FeedToken); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.FeedToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFreeBusy provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *Application) GetFreeBusy(_a0 context.Context, _a1 []int64, _a2 time.Time, _a3 time.Time) (model.FreeBusy, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFreeBusy")
+	}
+
+	var r0 model.FreeBusy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, time.Time, time.Time) (model.FreeBusy, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, time.Time, time.Time) model.FreeBusy); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Get(0).(model.FreeBusy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64, time.Time, time.Time) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInvitations provides a mock function with given fields: _a0, _a1
+func (_m *Application) GetInvitations(_a0 context.Context, _a1 int64) ([]model.Invitation, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInvitations")
+	}
+
+	var r0 []model.Invitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]model.Invitation, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []model.Invitation); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Invitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrash provides a mock function with given fields: _a0, _a1
+func (_m *Application) GetTrash(_a0 context.Context, _a1 int64) ([]model.Event, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrash")
+	}
+
+	var r0 []model.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]model.Event, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []model.Event); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUser provides a mock function with given fields: _a0, _a1
+func (_m *Application) GetUser(_a0 context.Context, _a1 int64) (model.User, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (model.User, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) model.User); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportEvents provides a mock function with given fields: _a0, _a1, _a2
+func (_m *Application) ImportEvents(_a0 context.Context, _a1 int64, _a2 io.Reader) (model.ImportReport, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportEvents")
+	}
+
+	var r0 model.ImportReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, io.Reader) (model.ImportReport, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, io.Reader) model.ImportReport); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(model.ImportReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, io.Reader) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // InsertEvent provides a mock function with given fields: _a0, _a1
 func (_m *Application) InsertEvent(_a0 context.Context, _a1 *model.Event) error {
 	ret := _m.Called(_a0, _a1)
@@ -200,6 +693,160 @@ func (_m *Application) InsertEvent(_a0 context.Context, _a1 *model.Event) error
 	return r0
 }
 
+// InviteAttendee provides a mock function with given fields: _a0, _a1, _a2
+func (_m *Application) InviteAttendee(_a0 context.Context, _a1 int64, _a2 int64) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InviteAttendee")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveAttendee provides a mock function with given fields: _a0, _a1, _a2
+func (_m *Application) RemoveAttendee(_a0 context.Context, _a1 int64, _a2 int64) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAttendee")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RespondInvitation provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *Application) RespondInvitation(_a0 context.Context, _a1 int64, _a2 int64, _a3 model.PartStat) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RespondInvitation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, model.PartStat) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreEvent provides a mock function with given fields: _a0, _a1, _a2
+func (_m *Application) RestoreEvent(_a0 context.Context, _a1 int64, _a2 int64) (model.Event, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreEvent")
+	}
+
+	var r0 model.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.Event, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.Event); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(model.Event)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeFeedToken provides a mock function with given fields: _a0, _a1, _a2
+func (_m *Application) RevokeFeedToken(_a0 context.Context, _a1 int64, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeFeedToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ShareCalendar provides a mock function with given fields: _a0, _a1
+func (_m *Application) ShareCalendar(_a0 context.Context, _a1 *model.CalendarShare) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ShareCalendar")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CalendarShare) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnshareCalendar provides a mock function with given fields: _a0, _a1, _a2
+func (_m *Application) UnshareCalendar(_a0 context.Context, _a1 int64, _a2 int64) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnshareCalendar")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCalendar provides a mock function with given fields: _a0, _a1
+func (_m *Application) UpdateCalendar(_a0 context.Context, _a1 *model.Calendar) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCalendar")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Calendar) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateEvent provides a mock function with given fields: _a0, _a1
 func (_m *Application) UpdateEvent(_a0 context.Context, _a1 *model.Event) error {
 	ret := _m.Called(_a0, _a1)
@@ -218,6 +865,24 @@ func (_m *Application) UpdateEvent(_a0 context.Context, _a1 *model.Event) error
 	return r0
 }
 
+// UpdateUser provides a mock function with given fields: _a0, _a1
+func (_m *Application) UpdateUser(_a0 context.Context, _a1 *model.User) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.User) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewApplication creates a new instance of Application. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewApplication(t interface {
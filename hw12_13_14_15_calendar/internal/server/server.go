@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"time"
 
@@ -11,15 +12,37 @@ import (
 )
 
 var (
-	ErrID             = errors.New("wrong ID")
-	ErrUserID         = errors.New("wrong UserID")
-	ErrTitle          = errors.New("wrong Title")
-	ErrDescription    = errors.New("wrong Description")
-	ErrOnTime         = errors.New("wrong OnTime")
-	ErrOffTime        = errors.New("wrong OffTime")
-	ErrNotifyTime     = errors.New("wrong NotifyTime")
-	ErrEventNotFound  = errors.New("event not found")
-	ErrTooLongCloseDB = errors.New("too long close db")
+	ErrID                 = errors.New("wrong ID")
+	ErrUserID             = errors.New("wrong UserID")
+	ErrTitle              = errors.New("wrong Title")
+	ErrDescription        = errors.New("wrong Description")
+	ErrOnTime             = errors.New("wrong OnTime")
+	ErrOffTime            = errors.New("wrong OffTime")
+	ErrNotifyTime         = errors.New("wrong NotifyTime")
+	ErrRRule              = errors.New("wrong RRule")
+	ErrTimeZone           = errors.New("wrong TimeZone")
+	ErrICalendar          = errors.New("wrong iCalendar")
+	ErrFeedToken          = errors.New("wrong feed token")
+	ErrCalendarName       = errors.New("wrong calendar Name")
+	ErrSharePermission    = errors.New("wrong share permission")
+	ErrAttendee           = errors.New("wrong attendee")
+	ErrPartStat           = errors.New("wrong participation status")
+	ErrDuration           = errors.New("wrong Duration")
+	ErrWorkingHours       = errors.New("wrong working hours")
+	ErrStatus             = errors.New("wrong Status")
+	ErrConflictPolicy     = errors.New("wrong conflict policy")
+	ErrEmail              = errors.New("wrong Email")
+	ErrLocale             = errors.New("wrong Locale")
+	ErrRequest            = errors.New("wrong request")
+	ErrUnauthenticated    = errors.New("unauthenticated")
+	ErrPermission         = errors.New("permission denied")
+	ErrEventNotFound      = errors.New("event not found")
+	ErrDateBusy           = errors.New("date is busy")
+	ErrVersionConflict    = errors.New("event version conflict")
+	ErrCalendarNotFound   = errors.New("calendar not found")
+	ErrShareNotFound      = errors.New("calendar share not found")
+	ErrInvitationNotFound = errors.New("invitation not found")
+	ErrTooLongCloseDB     = errors.New("too long close db")
 )
 
 //go:generate mockery --name Logger
@@ -37,10 +60,37 @@ type Application interface {
 	UpdateEvent(context.Context, *model.Event) error
 	DeleteEvent(context.Context, int64) error
 	GetEventByID(context.Context, int64) (model.Event, error)
+	GetEventHistory(context.Context, int64) ([]model.AuditEntry, error)
+	GetTrash(context.Context, int64) ([]model.Event, error)
+	RestoreEvent(context.Context, int64, int64) (model.Event, error)
 	GetAllEvents(context.Context, int64) ([]model.Event, error)
-	GetAllEventsDay(context.Context, int64, time.Time) ([]model.Event, error)
-	GetAllEventsWeek(context.Context, int64, time.Time) ([]model.Event, error)
-	GetAllEventsMonth(context.Context, int64, time.Time) ([]model.Event, error)
+	GetAllEventsDay(context.Context, int64, time.Time, string) ([]model.Event, error)
+	GetAllEventsWeek(context.Context, int64, time.Time, string) ([]model.Event, error)
+	GetAllEventsMonth(context.Context, int64, time.Time, string) ([]model.Event, error)
+	GetAllEventsRange(context.Context, int64, time.Time, time.Time) ([]model.Event, error)
+	GetUser(context.Context, int64) (model.User, error)
+	UpdateUser(context.Context, *model.User) error
+	ImportEvents(context.Context, int64, io.Reader) (model.ImportReport, error)
+	ExportEvents(context.Context, int64, io.Writer) error
+	CreateFeedToken(context.Context, int64) (model.FeedToken, error)
+	GetFeedTokens(context.Context, int64) ([]model.FeedToken, error)
+	RevokeFeedToken(context.Context, int64, string) error
+	GetFeed(context.Context, string) (model.Feed, error)
+	CreateCalendar(context.Context, *model.Calendar) error
+	UpdateCalendar(context.Context, *model.Calendar) error
+	DeleteCalendar(context.Context, int64) error
+	GetCalendar(context.Context, int64) (model.Calendar, error)
+	GetCalendars(context.Context, int64) ([]model.Calendar, error)
+	ShareCalendar(context.Context, *model.CalendarShare) error
+	UnshareCalendar(context.Context, int64, int64) error
+	GetCalendarShares(context.Context, int64) ([]model.CalendarShare, error)
+	GetCalendarsEvents(context.Context, []int64, time.Time, time.Time) ([]model.Event, error)
+	InviteAttendee(context.Context, int64, int64) error
+	RespondInvitation(context.Context, int64, int64, model.PartStat) error
+	RemoveAttendee(context.Context, int64, int64) error
+	GetInvitations(context.Context, int64) ([]model.Invitation, error)
+	GetFreeBusy(context.Context, []int64, time.Time, time.Time) (model.FreeBusy, error)
+	FindSlots(context.Context, model.SlotQuery) ([]model.Interval, error)
 }
 
 func Exitfail(msg string) {
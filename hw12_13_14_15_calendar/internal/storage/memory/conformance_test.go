@@ -0,0 +1,15 @@
+package memorystorage_test
+
+import (
+	"testing"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/storage"
+	memorystorage "github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/storage/memory"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/storage/storagetest"
+)
+
+func TestConformance(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storage.Storage {
+		return memorystorage.New()
+	})
+}
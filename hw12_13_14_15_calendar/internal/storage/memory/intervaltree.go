@@ -0,0 +1,156 @@
+package memorystorage
+
+import (
+	"math/rand"
+	"time"
+)
+
+// intervalTree is a treap of intervals ordered by start, each node keeps the latest end of its subtree,
+// so the intervals intersecting a window are found in O(log n + k).
+type intervalTree struct {
+	root *intervalNode
+	size int
+}
+
+type intervalNode struct {
+	start    time.Time
+	end      time.Time
+	maxEnd   time.Time
+	id       int64
+	priority uint64
+	left     *intervalNode
+	right    *intervalNode
+}
+
+// before orders nodes by start, the ID breaks ties so equal intervals of different events coexist.
+func before(start time.Time, id int64, n *intervalNode) bool {
+	return start.Before(n.start) || (start.Equal(n.start) && id < n.id)
+}
+
+func (n *intervalNode) update() {
+	n.maxEnd = n.end
+	if n.left != nil && n.left.maxEnd.After(n.maxEnd) {
+		n.maxEnd = n.left.maxEnd
+	}
+	if n.right != nil && n.right.maxEnd.After(n.maxEnd) {
+		n.maxEnd = n.right.maxEnd
+	}
+}
+
+func rotateRight(n *intervalNode) *intervalNode {
+	l := n.left
+	n.left = l.right
+	n.update()
+	l.right = n
+	l.update()
+	return l
+}
+
+func rotateLeft(n *intervalNode) *intervalNode {
+	r := n.right
+	n.right = r.left
+	n.update()
+	r.left = n
+	r.update()
+	return r
+}
+
+func insertNode(n, x *intervalNode) *intervalNode {
+	if n == nil {
+		return x
+	}
+	if before(x.start, x.id, n) {
+		n.left = insertNode(n.left, x)
+		if n.left.priority > n.priority {
+			n = rotateRight(n)
+		}
+	} else {
+		n.right = insertNode(n.right, x)
+		if n.right.priority > n.priority {
+			n = rotateLeft(n)
+		}
+	}
+	n.update()
+	return n
+}
+
+// mergeNodes joins two treaps where every start of a is before every start of b.
+func mergeNodes(a, b *intervalNode) *intervalNode {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	case a.priority > b.priority:
+		a.right = mergeNodes(a.right, b)
+		a.update()
+		return a
+	default:
+		b.left = mergeNodes(a, b.left)
+		b.update()
+		return b
+	}
+}
+
+func deleteNode(n *intervalNode, start time.Time, id int64) (*intervalNode, bool) {
+	if n == nil {
+		return nil, false
+	}
+	var deleted bool
+	switch {
+	case n.id == id && n.start.Equal(start):
+		return mergeNodes(n.left, n.right), true
+	case before(start, id, n):
+		n.left, deleted = deleteNode(n.left, start, id)
+	default:
+		n.right, deleted = deleteNode(n.right, start, id)
+	}
+	n.update()
+	return n, deleted
+}
+
+func (t *intervalTree) insert(id int64, start, end time.Time) {
+	x := &intervalNode{start: start, end: end, maxEnd: end, id: id, priority: rand.Uint64()}
+	t.root = insertNode(t.root, x)
+	t.size++
+}
+
+func (t *intervalTree) delete(id int64, start time.Time) {
+	var deleted bool
+	if t.root, deleted = deleteNode(t.root, start, id); deleted {
+		t.size--
+	}
+}
+
+// intersecting calls fn for the intervals with start <= end and end >= begin, in the order of start.
+func (t *intervalTree) intersecting(begin, end time.Time, fn func(id int64)) {
+	var walk func(n *intervalNode)
+	walk = func(n *intervalNode) {
+		if n == nil || n.maxEnd.Before(begin) {
+			return
+		}
+		walk(n.left)
+		if n.start.After(end) {
+			return
+		}
+		if !n.end.Before(begin) {
+			fn(n.id)
+		}
+		walk(n.right)
+	}
+	walk(t.root)
+}
+
+// each calls fn for all intervals in the order of start.
+func (t *intervalTree) each(fn func(id int64)) {
+	var walk func(n *intervalNode)
+	walk = func(n *intervalNode) {
+		if n == nil {
+			return
+		}
+		walk(n.left)
+		fn(n.id)
+		walk(n.right)
+	}
+	walk(t.root)
+}
@@ -0,0 +1,132 @@
+package memorystorage
+
+import (
+	"context"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+)
+
+// EnqueueNotifications writes the notifications due at date to the outbox and marks their occurrences
+// enqueued at once, so they are not due anymore. It returns how many notifications were written.
+func (s *Storage) EnqueueNotifications(ctx context.Context, date time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var due []int64
+	s.notices.intersecting(time.Time{}, date, func(eID int64) {
+		due = append(due, eID)
+	})
+
+	enqueued := int64(0)
+	now := time.Now().UTC()
+	for _, id := range due {
+		e := s.data[id]
+		occ, ok := e.DueOccurrence(date)
+		if !ok {
+			continue
+		}
+		s.unindex(id)
+		e.MarkEnqueued(occ)
+		s.index(e)
+		for _, msg := range model.NotificationMsgs(occ) {
+			if _, ok := s.outboxKeys[msg.Key]; ok {
+				continue
+			}
+			s.outboxID++
+			entry := &model.OutboxEntry{ID: s.outboxID, Msg: msg, Created: now}
+			s.outbox = append(s.outbox, entry)
+			s.outboxKeys[msg.Key] = entry
+			enqueued++
+		}
+	}
+	return enqueued, nil
+}
+
+// GetOutbox returns up to limit notifications not published yet, the oldest first, all when limit is not positive.
+func (s *Storage) GetOutbox(ctx context.Context, limit int) ([]model.OutboxEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	entries := []model.OutboxEntry{}
+	for _, entry := range s.outbox {
+		if limit > 0 && len(entries) == limit {
+			break
+		}
+		if entry.Sent.IsZero() {
+			entries = append(entries, *entry)
+		}
+	}
+	return entries, nil
+}
+
+func (s *Storage) MarkOutboxSent(ctx context.Context, id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, entry := range s.outbox {
+		if entry.ID == id {
+			entry.Sent = time.Now().UTC()
+			return nil
+		}
+	}
+	return nil
+}
+
+// MarkOutboxFailed counts a failed publishing of a notification and keeps its reason.
+func (s *Storage) MarkOutboxFailed(ctx context.Context, id int64, reason string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, entry := range s.outbox {
+		if entry.ID == id {
+			entry.Attempts++
+			entry.Error = reason
+			return nil
+		}
+	}
+	return nil
+}
+
+// PurgeOutbox removes the notifications published before date and the deliveries recorded before date.
+func (s *Storage) PurgeOutbox(ctx context.Context, date time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, delivered := range s.deliveries {
+		if delivered.Before(date) {
+			delete(s.deliveries, key)
+		}
+	}
+	kept := s.outbox[:0]
+	purged := int64(0)
+	for _, entry := range s.outbox {
+		if !entry.Sent.IsZero() && entry.Sent.Before(date) {
+			delete(s.outboxKeys, entry.Msg.Key)
+			purged++
+			continue
+		}
+		kept = append(kept, entry)
+	}
+	s.outbox = kept
+	return purged, nil
+}
+
+// IsNotificationDelivered reports whether the notification of key was delivered,
+// the key of a notification or of its delivery through one channel.
+func (s *Storage) IsNotificationDelivered(ctx context.Context, key string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, ok := s.deliveries[key]
+	return ok, nil
+}
+
+// MarkNotificationDelivered marks the notification of key delivered, with its outbox entry if there is one.
+func (s *Storage) MarkNotificationDelivered(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.deliveries[key]; ok {
+		return nil
+	}
+	now := time.Now().UTC()
+	s.deliveries[key] = now
+	if entry, ok := s.outboxKeys[key]; ok {
+		entry.Delivered = now
+	}
+	return nil
+}
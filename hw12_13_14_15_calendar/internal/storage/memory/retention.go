@@ -0,0 +1,64 @@
+package memorystorage
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+)
+
+// GetEventsOlderDate returns the events ended before date, the oldest first, at most limit of them
+// when limit is positive.
+func (s *Storage) GetEventsOlderDate(ctx context.Context, date time.Time, limit int) ([]model.Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	events := []model.Event{}
+	for _, v := range s.data {
+		if last, ok := v.LastOffTime(); ok && last.Before(date) {
+			events = append(events, *v)
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		last, _ := events[i].LastOffTime()
+		other, _ := events[j].LastOffTime()
+		if last.Equal(other) {
+			return events[i].ID < events[j].ID
+		}
+		return last.Before(other)
+	})
+	if limit > 0 && len(events) > limit {
+		events = events[:limit]
+	}
+	return events, nil
+}
+
+// DeleteEvents moves events to the trash and returns how many were moved, missing events are skipped.
+func (s *Storage) DeleteEvents(ctx context.Context, ids []int64) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	deleted := int64(0)
+	now := time.Now().UTC()
+	for _, id := range ids {
+		if _, ok := s.data[id]; ok {
+			s.trashEvent(ctx, id, now)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// DeleteEventsOlderDate moves the events ended before date to the trash.
+func (s *Storage) DeleteEventsOlderDate(ctx context.Context, date time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	deleted := int64(0)
+	now := time.Now().UTC()
+	for id, v := range s.data {
+		if last, ok := v.LastOffTime(); ok && last.Before(date) {
+			s.trashEvent(ctx, id, now)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
@@ -2,34 +2,79 @@ package memorystorage
 
 import (
 	"context"
-	"errors"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/storage/storageerr"
 )
 
 type mapEvent map[int64]*model.Event
 
+type shareKey struct {
+	calendarID int64
+	userID     int64
+}
+
+// indexKey records where an event is indexed, so it is removed with the keys it was added with.
+type indexKey struct {
+	start      time.Time
+	notice     time.Time
+	noticed    bool
+	users      []int64
+	calendarID int64
+}
+
+// Storage keeps the events of each user, including the accepted invitations, and of each calendar
+// in interval trees of their spans, and the events to notify in a tree ordered by the next notify time.
+// Deleted events are moved to the trash, out of the trees.
 type Storage struct {
-	data mapEvent
-	mu   sync.RWMutex
+	data          mapEvent
+	trash         mapEvent
+	keys          map[int64]indexKey
+	agendas       map[int64]*intervalTree
+	calendarSpans map[int64]*intervalTree
+	notices       *intervalTree
+	users         map[int64]model.User
+	feeds         map[string]model.FeedToken
+	calendars     map[int64]model.Calendar
+	shares        map[shareKey]model.Permission
+	audit         map[int64][]model.AuditEntry
+	auditID       int64
+	outbox        []*model.OutboxEntry
+	outboxKeys    map[string]*model.OutboxEntry
+	outboxID      int64
+	deliveries    map[string]time.Time
+	mu            sync.RWMutex
 }
 
 var (
-	ErrEventNotFound = errors.New("event not found")
-	ErrDateBusy      = errors.New("data is busy")
+	ErrEventNotFound     = storageerr.ErrEventNotFound
+	ErrFeedTokenNotFound = storageerr.ErrFeedTokenNotFound
+	ErrCalendarNotFound  = storageerr.ErrCalendarNotFound
+	ErrShareNotFound     = storageerr.ErrShareNotFound
+	ErrAttendeeNotFound  = storageerr.ErrAttendeeNotFound
+	ErrVersionConflict   = storageerr.ErrVersionConflict
 )
 
-var GenID int64 = 0
+var (
+	GenID         int64 = 0
+	GenCalendarID int64 = 0
+)
 
 func getNewIDSafe() int64 {
 	return atomic.AddInt64(&GenID, 1)
 }
 
 func New() *Storage {
-	return &Storage{data: make(mapEvent), mu: sync.RWMutex{}}
+	return &Storage{data: make(mapEvent), trash: make(mapEvent), keys: make(map[int64]indexKey),
+		agendas: make(map[int64]*intervalTree), calendarSpans: make(map[int64]*intervalTree),
+		notices: &intervalTree{}, users: make(map[int64]model.User),
+		feeds: make(map[string]model.FeedToken), calendars: make(map[int64]model.Calendar),
+		shares: make(map[shareKey]model.Permission), audit: make(map[int64][]model.AuditEntry),
+		outboxKeys: make(map[string]*model.OutboxEntry), deliveries: make(map[string]time.Time), mu: sync.RWMutex{}}
 }
 
 func (s *Storage) Connect(ctx context.Context) error {
@@ -40,78 +85,223 @@ func (s *Storage) Close(ctx context.Context) error {
 	return nil
 }
 
-func (s *Storage) inTimeSpan(start, end, check time.Time) bool {
-	switch {
-	case check.Equal(start):
-		return true
-	case check.Equal(end):
-		return true
-	case check.After(start) && check.Before(end):
-		return true
+// agendaUsers returns the users who see an event in their range: the owner and the accepted attendees.
+func agendaUsers(e *model.Event) []int64 {
+	users := []int64{e.UserID}
+	for _, a := range e.Attendees {
+		if a.Status == model.PartStatAccepted && a.UserID != e.UserID {
+			users = append(users, a.UserID)
+		}
+	}
+	return users
+}
+
+func treeOf(trees map[int64]*intervalTree, key int64) *intervalTree {
+	tree, ok := trees[key]
+	if !ok {
+		tree = &intervalTree{}
+		trees[key] = tree
 	}
-	return false
+	return tree
 }
 
-func (s *Storage) IsBusyDateTimeRange(ctx context.Context, id, userID int64, onTime, offTime time.Time) error {
+func deleteFrom(trees map[int64]*intervalTree, key, id int64, start time.Time) {
+	tree, ok := trees[key]
+	if !ok {
+		return
+	}
+	tree.delete(id, start)
+	if tree.size == 0 {
+		delete(trees, key)
+	}
+}
+
+// index adds a stored event to the trees, it must be called with the lock held.
+func (s *Storage) index(e *model.Event) {
+	start, end := e.Span()
+	key := indexKey{start: start, users: agendaUsers(e), calendarID: e.CalendarID}
+	for _, userID := range key.users {
+		treeOf(s.agendas, userID).insert(e.ID, start, end)
+	}
+	if key.calendarID != 0 {
+		treeOf(s.calendarSpans, key.calendarID).insert(e.ID, start, end)
+	}
+	if key.notice, key.noticed = e.NextNotice(); key.noticed {
+		s.notices.insert(e.ID, key.notice, key.notice)
+	}
+	s.keys[e.ID] = key
+}
+
+// unindex removes an event from the trees, it must be called with the lock held.
+func (s *Storage) unindex(id int64) {
+	key, ok := s.keys[id]
+	if !ok {
+		return
+	}
+	for _, userID := range key.users {
+		deleteFrom(s.agendas, userID, id, key.start)
+	}
+	if key.calendarID != 0 {
+		deleteFrom(s.calendarSpans, key.calendarID, id, key.start)
+	}
+	if key.noticed {
+		s.notices.delete(id, key.notice)
+	}
+	delete(s.keys, id)
+}
+
+// GetOverlaps returns the occurrences of the other events of a user overlapping [onTime, offTime),
+// events sharing only an endpoint with the range don't overlap it.
+func (s *Storage) GetOverlaps(ctx context.Context, id, userID int64, onTime, offTime time.Time) ([]model.Event, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	for _, v := range s.data {
-		if v.UserID == userID && v.ID != id &&
-			(s.inTimeSpan(v.OnTime, v.OffTime, onTime) ||
-				s.inTimeSpan(v.OnTime, v.OffTime, offTime)) {
-			return ErrDateBusy
+	overlaps := []model.Event{}
+	tree, ok := s.agendas[userID]
+	if !ok {
+		return overlaps, nil
+	}
+	var err error
+	tree.intersecting(onTime, offTime, func(eID int64) {
+		v := s.data[eID]
+		if err != nil || v.UserID != userID || v.ID == id {
+			return
+		}
+		var occurrences []model.Event
+		if occurrences, err = v.Occurrences(onTime, offTime); err != nil {
+			return
+		}
+		for _, o := range occurrences {
+			if model.Overlaps(o.OnTime, o.OffTime, onTime, offTime) {
+				overlaps = append(overlaps, o)
+			}
 		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return overlaps, nil
 }
 
+// InsertEvent stores a copy of an event with its attendees at version 1.
 func (s *Storage) InsertEvent(ctx context.Context, e *model.Event) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	e.ID = getNewIDSafe()
-	s.data[e.ID] = e
+	e.Version = 1
+	e.Attendees = append([]model.Attendee(nil), e.Attendees...)
+	sort.Slice(e.Attendees, func(i, j int) bool { return e.Attendees[i].UserID < e.Attendees[j].UserID })
+	stored := *e
+	s.data[e.ID] = &stored
+	s.index(&stored)
+	s.record(ctx, model.AuditInsert, e.ID, nil, &stored)
 	return nil
 }
 
+// UpdateEvent replaces an event, the attendees are kept as they are changed by their own methods
+// and the notification state as it is changed by the scheduler and the sender.
+// A non-zero version of e must be the stored one, the version is incremented.
 func (s *Storage) UpdateEvent(ctx context.Context, e *model.Event) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	if _, ok := s.data[e.ID]; !ok {
+	old, ok := s.data[e.ID]
+	if !ok {
 		return ErrEventNotFound
 	}
-	s.data[e.ID] = e
+	if e.Version != 0 && e.Version != old.Version {
+		return ErrVersionConflict
+	}
+	e.Attendees = old.Attendees
+	e.Notified, e.LastNotified = old.Notified, old.LastNotified
+	e.Enqueued, e.LastEnqueued = old.Enqueued, old.LastEnqueued
+	e.Version = old.Version + 1
+	e.DeletedAt = time.Time{}
+	stored := *e
+	s.unindex(e.ID)
+	s.data[e.ID] = &stored
+	s.index(&stored)
+	s.record(ctx, model.AuditUpdate, e.ID, old, &stored)
 	return nil
 }
 
+// DeleteEvent moves an event to the trash.
 func (s *Storage) DeleteEvent(ctx context.Context, id int64) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	delete(s.data, id)
+	if _, ok := s.data[id]; !ok {
+		return ErrEventNotFound
+	}
+	s.trashEvent(ctx, id, time.Now().UTC())
 	return nil
 }
 
+// trashEvent moves a stored event to the trash, it must be called with the lock held.
+func (s *Storage) trashEvent(ctx context.Context, id int64, now time.Time) {
+	e := s.data[id]
+	s.record(ctx, model.AuditDelete, id, e, nil)
+	s.unindex(id)
+	delete(s.data, id)
+	e.DeletedAt = now
+	s.trash[id] = e
+}
+
+// deleteEvent removes a stored event for good, it must be called with the lock held.
+func (s *Storage) deleteEvent(ctx context.Context, id int64) {
+	s.record(ctx, model.AuditDelete, id, s.data[id], nil)
+	s.unindex(id)
+	delete(s.data, id)
+}
+
+// purgeEvent removes a trashed event for good, it must be called with the lock held.
+func (s *Storage) purgeEvent(ctx context.Context, id int64) {
+	s.record(ctx, model.AuditPurge, id, s.trash[id], nil)
+	delete(s.trash, id)
+}
+
 func (s *Storage) GetAllEvents(ctx context.Context, userID int64) ([]model.Event, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 	sliceE := []model.Event{}
-	for _, v := range s.data {
-		if v.UserID == userID {
-			sliceE = append(sliceE, *v)
-		}
+	if tree, ok := s.agendas[userID]; ok {
+		tree.each(func(eID int64) {
+			if v := s.data[eID]; v.UserID == userID {
+				sliceE = append(sliceE, *v)
+			}
+		})
 	}
 	return sliceE, nil
 }
 
+// GetAllRange returns the events of a user in [begin, end), with the events the user accepted as an attendee.
 func (s *Storage) GetAllRange(ctx context.Context, userID int64, begin, end time.Time) ([]model.Event, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
+	return s.getRange(s.agendas[userID], begin, end)
+}
+
+// GetCalendarRange returns the events of a calendar in [begin, end).
+func (s *Storage) GetCalendarRange(ctx context.Context, calendarID int64, begin, end time.Time) ([]model.Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.getRange(s.calendarSpans[calendarID], begin, end)
+}
+
+// getRange returns the occurrences in a range of the events of a tree, it must be called with the lock held.
+func (s *Storage) getRange(tree *intervalTree, begin, end time.Time) ([]model.Event, error) {
 	sliceE := []model.Event{}
-	for _, v := range s.data {
-		if v.UserID == userID &&
-			(s.inTimeSpan(begin, end, v.OnTime) ||
-				s.inTimeSpan(begin, end, v.OffTime)) {
-			sliceE = append(sliceE, *v)
+	if tree == nil {
+		return sliceE, nil
+	}
+	var err error
+	tree.intersecting(begin, end, func(eID int64) {
+		if err != nil {
+			return
 		}
+		var occurrences []model.Event
+		occurrences, err = s.data[eID].Occurrences(begin, end)
+		sliceE = append(sliceE, occurrences...)
+	})
+	if err != nil {
+		return nil, err
 	}
 	return sliceE, nil
 }
@@ -127,38 +317,308 @@ func (s *Storage) GetEventByID(ctx context.Context, eID int64) (model.Event, err
 	return event, ErrEventNotFound
 }
 
+// GetEventsDayOfNotice returns the occurrences to notify at date, found by the next notify time of the events.
 func (s *Storage) GetEventsDayOfNotice(ctx context.Context, date time.Time) ([]model.Event, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 	sliceE := []model.Event{}
 
-	for _, v := range s.data {
-		if !v.Notified && (v.NotifyTime.Before(date) || v.NotifyTime.Equal(date)) {
-			sliceE = append(sliceE, *v)
+	s.notices.intersecting(time.Time{}, date, func(eID int64) {
+		if occ, ok := s.data[eID].DueOccurrence(date); ok {
+			sliceE = append(sliceE, occ)
 		}
-	}
+	})
 	return sliceE, nil
 }
 
 func (s *Storage) UpdateEventNotified(ctx context.Context, eventid int64) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	if _, ok := s.data[eventid]; !ok {
+	e, ok := s.data[eventid]
+	if !ok {
+		return ErrEventNotFound
+	}
+	before := *e
+	s.unindex(eventid)
+	e.MarkNotified(time.Now())
+	s.index(e)
+	s.record(ctx, model.AuditNotified, eventid, &before, e)
+	return nil
+}
+
+func (s *Storage) GetUser(ctx context.Context, userID int64) (model.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if u, ok := s.users[userID]; ok {
+		return u, nil
+	}
+	return model.User{ID: userID}, nil
+}
+
+func (s *Storage) UpsertUser(ctx context.Context, u *model.User) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.users[u.ID] = *u
+	return nil
+}
+
+func (s *Storage) InsertFeedToken(ctx context.Context, t *model.FeedToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.feeds[t.Token] = *t
+	return nil
+}
+
+func (s *Storage) UpdateFeedToken(ctx context.Context, t *model.FeedToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.feeds[t.Token]; !ok {
+		return ErrFeedTokenNotFound
+	}
+	s.feeds[t.Token] = *t
+	return nil
+}
+
+func (s *Storage) DeleteFeedToken(ctx context.Context, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.feeds[token]; !ok {
+		return ErrFeedTokenNotFound
+	}
+	delete(s.feeds, token)
+	return nil
+}
+
+func (s *Storage) GetFeedToken(ctx context.Context, token string) (model.FeedToken, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if t, ok := s.feeds[token]; ok {
+		return t, nil
+	}
+	return model.FeedToken{}, ErrFeedTokenNotFound
+}
+
+func (s *Storage) GetFeedTokens(ctx context.Context, userID int64) ([]model.FeedToken, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	tokens := []model.FeedToken{}
+	for _, t := range s.feeds {
+		if t.UserID == userID {
+			tokens = append(tokens, t)
+		}
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].Created.Before(tokens[j].Created) })
+	return tokens, nil
+}
+
+func (s *Storage) InsertCalendar(ctx context.Context, c *model.Calendar) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c.ID = atomic.AddInt64(&GenCalendarID, 1)
+	c.Permission = ""
+	s.calendars[c.ID] = *c
+	return nil
+}
+
+func (s *Storage) UpdateCalendar(ctx context.Context, c *model.Calendar) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.calendars[c.ID]
+	if !ok {
+		return ErrCalendarNotFound
+	}
+	stored.Name = c.Name
+	stored.Description = c.Description
+	stored.ConflictPolicy = c.ConflictPolicy
+	s.calendars[c.ID] = stored
+	return nil
+}
+
+// DeleteCalendar deletes a calendar with its events, trashed ones included, and shares.
+func (s *Storage) DeleteCalendar(ctx context.Context, id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.calendars[id]; !ok {
+		return ErrCalendarNotFound
+	}
+	delete(s.calendars, id)
+	if tree, ok := s.calendarSpans[id]; ok {
+		ids := make([]int64, 0, tree.size)
+		tree.each(func(eID int64) { ids = append(ids, eID) })
+		for _, eID := range ids {
+			s.deleteEvent(ctx, eID)
+		}
+	}
+	for eID, e := range s.trash {
+		if e.CalendarID == id {
+			s.purgeEvent(ctx, eID)
+		}
+	}
+	for key := range s.shares {
+		if key.calendarID == id {
+			delete(s.shares, key)
+		}
+	}
+	return nil
+}
+
+func (s *Storage) GetCalendar(ctx context.Context, id int64) (model.Calendar, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if c, ok := s.calendars[id]; ok {
+		return c, nil
+	}
+	return model.Calendar{}, ErrCalendarNotFound
+}
+
+// GetCalendars returns the calendars owned by a user and the calendars shared with them,
+// the permission of each is the access of the user.
+func (s *Storage) GetCalendars(ctx context.Context, userID int64) ([]model.Calendar, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	calendars := []model.Calendar{}
+	for _, c := range s.calendars {
+		if c.OwnerID == userID {
+			c.Permission = model.PermissionOwner
+		} else if p, ok := s.shares[shareKey{c.ID, userID}]; ok {
+			c.Permission = p
+		} else {
+			continue
+		}
+		calendars = append(calendars, c)
+	}
+	sort.Slice(calendars, func(i, j int) bool { return calendars[i].ID < calendars[j].ID })
+	return calendars, nil
+}
+
+func (s *Storage) UpsertCalendarShare(ctx context.Context, cs *model.CalendarShare) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.calendars[cs.CalendarID]; !ok {
+		return ErrCalendarNotFound
+	}
+	s.shares[shareKey{cs.CalendarID, cs.UserID}] = cs.Permission
+	return nil
+}
+
+func (s *Storage) DeleteCalendarShare(ctx context.Context, calendarID, userID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := shareKey{calendarID, userID}
+	if _, ok := s.shares[key]; !ok {
+		return ErrShareNotFound
+	}
+	delete(s.shares, key)
+	return nil
+}
+
+func (s *Storage) GetCalendarShare(ctx context.Context, calendarID, userID int64) (model.CalendarShare, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	cs := model.CalendarShare{CalendarID: calendarID, UserID: userID}
+	p, ok := s.shares[shareKey{calendarID, userID}]
+	if !ok {
+		return cs, ErrShareNotFound
+	}
+	cs.Permission = p
+	return cs, nil
+}
+
+func (s *Storage) GetCalendarShares(ctx context.Context, calendarID int64) ([]model.CalendarShare, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	shares := []model.CalendarShare{}
+	for key, p := range s.shares {
+		if key.calendarID == calendarID {
+			shares = append(shares, model.CalendarShare{CalendarID: calendarID, UserID: key.userID, Permission: p})
+		}
+	}
+	sort.Slice(shares, func(i, j int) bool { return shares[i].UserID < shares[j].UserID })
+	return shares, nil
+}
+
+// SetAttendee adds an attendee to an event or changes its status.
+func (s *Storage) SetAttendee(ctx context.Context, eventID int64, a model.Attendee) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.data[eventID]
+	if !ok {
 		return ErrEventNotFound
 	}
-	s.data[eventid].Notified = true
+	attendees := make([]model.Attendee, 0, len(e.Attendees)+1)
+	for _, v := range e.Attendees {
+		if v.UserID != a.UserID {
+			attendees = append(attendees, v)
+		}
+	}
+	attendees = append(attendees, a)
+	sort.Slice(attendees, func(i, j int) bool { return attendees[i].UserID < attendees[j].UserID })
+	before := *e
+	s.unindex(eventID)
+	e.Attendees = attendees
+	e.Version++
+	s.index(e)
+	s.record(ctx, model.AuditUpdate, eventID, &before, e)
 	return nil
 }
 
-func (s *Storage) DeleteEventsOlderDate(ctx context.Context, date time.Time) (int64, error) {
+func (s *Storage) DeleteAttendee(ctx context.Context, eventID, userID int64) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	deleted := int64(0)
-	for id, v := range s.data {
-		if v.OffTime.Before(date) {
-			delete(s.data, id)
-			deleted++
+	e, ok := s.data[eventID]
+	if !ok {
+		return ErrEventNotFound
+	}
+	if _, ok := e.Attendee(userID); !ok {
+		return ErrAttendeeNotFound
+	}
+	attendees := make([]model.Attendee, 0, len(e.Attendees)-1)
+	for _, v := range e.Attendees {
+		if v.UserID != userID {
+			attendees = append(attendees, v)
+		}
+	}
+	before := *e
+	s.unindex(eventID)
+	e.Attendees = attendees
+	e.Version++
+	s.index(e)
+	s.record(ctx, model.AuditUpdate, eventID, &before, e)
+	return nil
+}
+
+// GetInvitations returns the events a user is invited to with the status of the user.
+func (s *Storage) GetInvitations(ctx context.Context, userID int64) ([]model.Invitation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	invitations := []model.Invitation{}
+	for _, v := range s.data {
+		if a, ok := v.Attendee(userID); ok {
+			invitations = append(invitations, model.Invitation{Event: *v, Status: a.Status})
 		}
 	}
-	return deleted, nil
+	sort.Slice(invitations, func(i, j int) bool { return invitations[i].Event.OnTime.Before(invitations[j].Event.OnTime) })
+	return invitations, nil
+}
+
+// record appends a change of an event to the audit log, it must be called with the lock held.
+func (s *Storage) record(ctx context.Context, action model.AuditAction, eventID int64, before, after *model.Event) {
+	s.auditID++
+	entry := model.AuditEntry{
+		ID: s.auditID, EventID: eventID, Action: action, Actor: model.ActorFromContext(ctx), Time: time.Now().UTC(),
+	}
+	if before != nil {
+		entry.Before = before.Snapshot()
+	}
+	if after != nil {
+		entry.After = after.Snapshot()
+	}
+	s.audit[eventID] = append(s.audit[eventID], entry)
+}
+
+// GetEventHistory returns the changes of an event from the oldest, the history outlives the event.
+func (s *Storage) GetEventHistory(ctx context.Context, eventID int64) ([]model.AuditEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]model.AuditEntry{}, s.audit[eventID]...), nil
 }
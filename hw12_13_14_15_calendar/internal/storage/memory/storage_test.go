@@ -3,6 +3,7 @@ package memorystorage
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"sync"
 	"testing"
 	"time"
@@ -272,3 +273,190 @@ func TestConcurrency_Update(t *testing.T) {
 		require.Contains(t, ev.Title, "title_", "Updates are not concurrently safe")
 	}
 }
+
+func TestRecurringGetAllRange(t *testing.T) {
+	s := New()
+	onTime := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	e := &model.Event{
+		UserID:  1,
+		Title:   "stand-up",
+		OnTime:  onTime,
+		OffTime: onTime.Add(30 * time.Minute),
+		RRule:   "FREQ=WEEKLY;COUNT=52",
+		ExDates: []time.Time{onTime.AddDate(0, 0, 14)},
+		Overrides: []model.EventOverride{
+			{RecurrenceID: onTime.AddDate(0, 0, 7), Title: "moved", OnTime: onTime.AddDate(0, 0, 8)},
+		},
+	}
+	require.NoError(t, s.InsertEvent(context.Background(), e))
+
+	events, err := s.GetAllRange(context.Background(), 1, onTime, onTime.AddDate(0, 1, 0))
+	require.NoError(t, err)
+	require.Len(t, events, 4) // 1, 9 (moved), 22, 29 of January
+
+	titles := map[string]int{}
+	for _, ev := range events {
+		require.Equal(t, e.ID, ev.ID)
+		require.False(t, ev.RecurrenceID.IsZero())
+		titles[ev.Title]++
+	}
+	require.Equal(t, 1, titles["moved"])
+	for _, ev := range events {
+		require.Equal(t, 30*time.Minute, ev.OffTime.Sub(ev.OnTime))
+	}
+
+	events, err = s.GetAllRange(context.Background(), 1, onTime.AddDate(2, 0, 0), onTime.AddDate(2, 1, 0))
+	require.NoError(t, err)
+	require.Empty(t, events)
+}
+
+func TestRecurringIsBusy(t *testing.T) {
+	s := New()
+	onTime := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	e := &model.Event{
+		UserID:  1,
+		OnTime:  onTime,
+		OffTime: onTime.Add(time.Hour),
+		RRule:   "FREQ=DAILY",
+	}
+	require.NoError(t, s.InsertEvent(context.Background(), e))
+
+	later := onTime.AddDate(0, 3, 0).Add(30 * time.Minute)
+	overlaps, err := s.GetOverlaps(context.Background(), 0, 1, later, later.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, overlaps, 1)
+
+	free := onTime.AddDate(0, 3, 0).Add(2 * time.Hour)
+	overlaps, err = s.GetOverlaps(context.Background(), 0, 1, free, free.Add(time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, overlaps)
+
+	// back-to-back occurrences share an endpoint only
+	next := onTime.AddDate(0, 3, 0).Add(time.Hour)
+	overlaps, err = s.GetOverlaps(context.Background(), 0, 1, next, next.Add(time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, overlaps)
+}
+
+func TestRecurringNotice(t *testing.T) {
+	s := New()
+	onTime := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	e := &model.Event{
+		UserID:     1,
+		OnTime:     onTime,
+		OffTime:    onTime.Add(time.Hour),
+		NotifyTime: onTime.Add(-15 * time.Minute),
+		RRule:      "FREQ=DAILY;COUNT=3",
+	}
+	require.NoError(t, s.InsertEvent(context.Background(), e))
+
+	date := onTime.AddDate(0, 0, 1).Add(-10 * time.Minute)
+	events, err := s.GetEventsDayOfNotice(context.Background(), date)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, onTime.AddDate(0, 0, 1), events[0].OnTime)
+
+	s.data[e.ID].MarkNotified(date)
+	events, err = s.GetEventsDayOfNotice(context.Background(), date)
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	deleted, err := s.DeleteEventsOlderDate(context.Background(), onTime.AddDate(0, 0, 2))
+	require.NoError(t, err)
+	require.Equal(t, int64(0), deleted)
+	deleted, err = s.DeleteEventsOlderDate(context.Background(), onTime.AddDate(0, 0, 4))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), deleted)
+}
+
+func TestContainingEvent(t *testing.T) {
+	s := New()
+	onTime := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	e := &model.Event{UserID: 1, CalendarID: 7, OnTime: onTime, OffTime: onTime.Add(8 * time.Hour)}
+	require.NoError(t, s.InsertEvent(context.Background(), e))
+
+	inside := onTime.Add(2 * time.Hour)
+	overlaps, err := s.GetOverlaps(context.Background(), 0, 1, inside, inside.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, overlaps, 1)
+	overlaps, err = s.GetOverlaps(context.Background(), e.ID, 1, inside, inside.Add(time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, overlaps)
+	overlaps, err = s.GetOverlaps(context.Background(), 0, 2, inside, inside.Add(time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, overlaps)
+
+	events, err := s.GetAllRange(context.Background(), 1, inside, inside.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	events, err = s.GetCalendarRange(context.Background(), 7, inside, inside.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	// moving the event moves it in the index
+	e.OnTime, e.OffTime = onTime.AddDate(0, 0, 1), onTime.AddDate(0, 0, 1).Add(time.Hour)
+	require.NoError(t, s.UpdateEvent(context.Background(), e))
+	events, err = s.GetAllRange(context.Background(), 1, inside, inside.Add(time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, events)
+	events, err = s.GetAllEvents(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+}
+
+func TestNoticeIndex(t *testing.T) {
+	s := New()
+	onTime := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	e := &model.Event{UserID: 1, OnTime: onTime, OffTime: onTime.Add(time.Hour), NotifyTime: onTime.Add(-time.Hour)}
+	require.NoError(t, s.InsertEvent(context.Background(), e))
+
+	events, err := s.GetEventsDayOfNotice(context.Background(), onTime.Add(-2*time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, events)
+	events, err = s.GetEventsDayOfNotice(context.Background(), onTime)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	require.NoError(t, s.UpdateEventNotified(context.Background(), e.ID))
+	require.Equal(t, 0, s.notices.size)
+	events, err = s.GetEventsDayOfNotice(context.Background(), onTime)
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	require.NoError(t, s.DeleteEvent(context.Background(), e.ID))
+	require.Empty(t, s.agendas)
+	require.Empty(t, s.keys)
+}
+
+func TestIntervalTree(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(n int) time.Time { return base.Add(time.Duration(n) * time.Minute) }
+	rnd := rand.New(rand.NewSource(1))
+
+	tree := &intervalTree{}
+	intervals := map[int64][2]int{}
+	for i := int64(1); i <= 500; i++ {
+		start := rnd.Intn(1000)
+		intervals[i] = [2]int{start, start + rnd.Intn(100)}
+		tree.insert(i, at(start), at(intervals[i][1]))
+	}
+	for i := int64(1); i <= 500; i += 3 {
+		tree.delete(i, at(intervals[i][0]))
+		delete(intervals, i)
+	}
+	require.Equal(t, len(intervals), tree.size)
+
+	for q := 0; q < 200; q++ {
+		begin := rnd.Intn(1100)
+		end := begin + rnd.Intn(50)
+		want := []int64{}
+		for id, i := range intervals {
+			if i[0] <= end && i[1] >= begin {
+				want = append(want, id)
+			}
+		}
+		got := []int64{}
+		tree.intersecting(at(begin), at(end), func(id int64) { got = append(got, id) })
+		require.ElementsMatch(t, want, got)
+	}
+}
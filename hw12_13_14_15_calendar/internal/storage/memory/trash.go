@@ -0,0 +1,59 @@
+package memorystorage
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+)
+
+// GetTrash returns the trashed events of a user, the latest deleted first.
+func (s *Storage) GetTrash(ctx context.Context, userID int64) ([]model.Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	events := []model.Event{}
+	for _, e := range s.trash {
+		if e.UserID == userID {
+			events = append(events, *e)
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if !events[i].DeletedAt.Equal(events[j].DeletedAt) {
+			return events[i].DeletedAt.After(events[j].DeletedAt)
+		}
+		return events[i].ID < events[j].ID
+	})
+	return events, nil
+}
+
+// RestoreEvent moves an event back from the trash.
+func (s *Storage) RestoreEvent(ctx context.Context, id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.trash[id]
+	if !ok {
+		return ErrEventNotFound
+	}
+	before := *e
+	delete(s.trash, id)
+	e.DeletedAt = time.Time{}
+	s.data[id] = e
+	s.index(e)
+	s.record(ctx, model.AuditRestore, id, &before, e)
+	return nil
+}
+
+// PurgeTrash removes for good the events trashed before date.
+func (s *Storage) PurgeTrash(ctx context.Context, date time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	purged := int64(0)
+	for id, e := range s.trash {
+		if e.DeletedAt.Before(date) {
+			s.purgeEvent(ctx, id)
+			purged++
+		}
+	}
+	return purged, nil
+}
@@ -0,0 +1,125 @@
+package sqlstorage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+)
+
+// eventState returns a stored or trashed event with the notification state recorded in the audit log.
+// In a transaction of PostgreSQL the row stays locked until the change and its audit entry are committed,
+// SQLite locks the whole database on the first write instead.
+func (s *Storage) eventState(ctx context.Context, id int64, trashed bool) (*model.Event, error) {
+	var notified sql.NullBool
+	var lastNotified sql.NullTime
+	query := `SELECT notified, lastnotified FROM events WHERE id = $1`
+	if s.driver != driverSQLite {
+		query += ` FOR UPDATE`
+	}
+	if err := s.db.QueryRowContext(ctx, query, id).Scan(&notified, &lastNotified); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrEventNotFound
+		}
+		return nil, fmt.Errorf("failed to get notified: %w", err)
+	}
+	e, err := s.getEvent(ctx, id, trashed)
+	if err != nil {
+		return nil, err
+	}
+	e.Notified = notified.Bool
+	if lastNotified.Valid {
+		e.LastNotified = lastNotified.Time
+	}
+	return &e, nil
+}
+
+func snapshotNull(e *model.Event) (sql.NullString, error) {
+	if e == nil {
+		return sql.NullString{}, nil
+	}
+	data, err := json.Marshal(e.Snapshot())
+	if err != nil {
+		return sql.NullString{}, fmt.Errorf("failed to marshal snapshot: %w", err)
+	}
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+// record appends a change of an event to the audit log.
+func (s *Storage) record(ctx context.Context, action model.AuditAction, eventID int64,
+	before, after *model.Event,
+) error {
+	beforeSQL, err := snapshotNull(before)
+	if err != nil {
+		return err
+	}
+	afterSQL, err := snapshotNull(after)
+	if err != nil {
+		return err
+	}
+	query := `INSERT INTO event_audit (eventid, action, actor, time, before, after) VALUES ($1, $2, $3, $4, $5, $6)`
+
+	_, err = s.db.ExecContext(ctx, query, eventID, string(action), model.ActorFromContext(ctx),
+		time.Now().UTC(), beforeSQL, afterSQL)
+	if err != nil {
+		return fmt.Errorf("failed to insert audit entry: %w", err)
+	}
+	return nil
+}
+
+// recordUpdate records the change of an event from before to its stored state.
+func (s *Storage) recordUpdate(ctx context.Context, action model.AuditAction, before *model.Event) error {
+	after, err := s.eventState(ctx, before.ID, false)
+	if err != nil {
+		return err
+	}
+	return s.record(ctx, action, before.ID, before, after)
+}
+
+// GetEventHistory returns the changes of an event from the oldest, the history outlives the event.
+func (s *Storage) GetEventHistory(ctx context.Context, eventID int64) ([]model.AuditEntry, error) {
+	query := `SELECT id, eventid, action, actor, time, before, after FROM event_audit WHERE eventid = $1 ORDER BY id`
+
+	rows, err := s.db.QueryContext(ctx, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event history: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []model.AuditEntry{}
+	for rows.Next() {
+		var entry model.AuditEntry
+		var before, after sql.NullString
+		if err := rows.Scan(&entry.ID, &entry.EventID, &entry.Action, &entry.Actor, &entry.Time,
+			&before, &after); err != nil {
+			return nil, fmt.Errorf("failed to rows.Scan: %w", err)
+		}
+		if entry.Before, err = parseSnapshot(before); err != nil {
+			return nil, err
+		}
+		if entry.After, err = parseSnapshot(after); err != nil {
+			return nil, err
+		}
+		entry.Time = entry.Time.UTC()
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to rows.Err: %w", err)
+	}
+	return entries, nil
+}
+
+func parseSnapshot(s sql.NullString) (*model.EventSnapshot, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	var snapshot model.EventSnapshot
+	if err := json.Unmarshal([]byte(s.String), &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal snapshot: %w", err)
+	}
+	return &snapshot, nil
+}
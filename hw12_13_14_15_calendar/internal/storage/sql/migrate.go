@@ -0,0 +1,98 @@
+package sqlstorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/migrations"
+	"github.com/pressly/goose/v3"
+)
+
+var (
+	ErrSchemaOutdated = errors.New("database schema is outdated")
+	ErrMigrateCommand = errors.New("unknown migrate command")
+)
+
+// migrations returns a goose provider of the embedded migrations of the driver.
+func (s *Storage) migrations() (*goose.Provider, error) {
+	dialect, fsys := goose.DialectPostgres, fs.FS(migrations.FS)
+	if s.driver == driverSQLite {
+		sub, err := fs.Sub(migrations.FS, "sqlite")
+		if err != nil {
+			return nil, err
+		}
+		dialect, fsys = goose.DialectSQLite3, sub
+	}
+	p, err := goose.NewProvider(dialect, s.pool.DB, fsys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load migrations: %w", err)
+	}
+	return p, nil
+}
+
+// Migrate runs a migrate command: "up" applies the pending migrations, "down" rolls back the last one
+// and "status" lists them. The applied migrations and the status are written to w.
+func (s *Storage) Migrate(ctx context.Context, command string, w io.Writer) error {
+	p, err := s.migrations()
+	if err != nil {
+		return err
+	}
+	switch command {
+	case "up":
+		results, err := p.Up(ctx)
+		for _, r := range results {
+			fmt.Fprintln(w, r)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to migrate up: %w", err)
+		}
+	case "down":
+		r, err := p.Down(ctx)
+		if r != nil {
+			fmt.Fprintln(w, r)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to migrate down: %w", err)
+		}
+	case "status":
+		statuses, err := p.Status(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get migration status: %w", err)
+		}
+		for _, st := range statuses {
+			applied := "Pending"
+			if st.State == goose.StateApplied {
+				applied = st.AppliedAt.Format(time.DateTime)
+			}
+			fmt.Fprintf(w, "%-19s -- %s\n", applied, filepath.Base(st.Source.Path))
+		}
+	default:
+		return fmt.Errorf("%w %q, want up, down or status", ErrMigrateCommand, command)
+	}
+	return nil
+}
+
+// CheckSchema returns ErrSchemaOutdated when the database lacks a migration embedded in the binary.
+func (s *Storage) CheckSchema(ctx context.Context) error {
+	p, err := s.migrations()
+	if err != nil {
+		return err
+	}
+	current, err := p.GetDBVersion(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get schema version: %w", err)
+	}
+	sources := p.ListSources()
+	if len(sources) == 0 {
+		return nil
+	}
+	if expected := sources[len(sources)-1].Version; current < expected {
+		return fmt.Errorf("%w: version %d, expected %d", ErrSchemaOutdated, current, expected)
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package sqlstorage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+)
+
+// EnqueueNotifications writes the notifications due at date to the outbox and marks their occurrences
+// enqueued in one transaction, so they are not due anymore. It returns how many notifications were written.
+func (s *Storage) EnqueueNotifications(ctx context.Context, date time.Time) (int64, error) {
+	due, err := s.GetEventsDayOfNotice(ctx, date)
+	if err != nil {
+		return 0, err
+	}
+	if len(due) == 0 {
+		return 0, nil
+	}
+
+	enqueued := int64(0)
+	now := time.Now().UTC()
+	err = s.inTx(ctx, func(tx *Storage) error {
+		for i := range due {
+			marked, err := tx.markEnqueued(ctx, due[i])
+			if err != nil {
+				return err
+			}
+			// another scheduler enqueued the occurrence meanwhile
+			if !marked {
+				continue
+			}
+			for _, msg := range model.NotificationMsgs(due[i]) {
+				query := `INSERT INTO outbox (dedupkey, eventid, userid, title, date, created)
+				          VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (dedupkey) DO NOTHING`
+				result, err := tx.db.ExecContext(ctx, query, msg.Key, msg.ID, msg.UserID, stringNull(msg.Title),
+					msg.Date.UTC(), now)
+				if err != nil {
+					return fmt.Errorf("failed to insert outbox: %w", err)
+				}
+				n, err := result.RowsAffected()
+				if err != nil {
+					return fmt.Errorf("failed to get rows affected: %w", err)
+				}
+				enqueued += n
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return enqueued, nil
+}
+
+// markEnqueued marks a due occurrence enqueued unless it is already, and reports whether it marked it.
+func (s *Storage) markEnqueued(ctx context.Context, occ model.Event) (bool, error) {
+	var result sql.Result
+	var err error
+	if occ.IsRecurring() {
+		query := `UPDATE events SET lastenqueued = $2 WHERE id = $1 AND (lastenqueued IS NULL OR lastenqueued < $2)`
+		result, err = s.db.ExecContext(ctx, query, occ.ID, occ.RecurrenceID.UTC())
+	} else {
+		query := `UPDATE events SET enqueued = true WHERE id = $1 AND enqueued = false`
+		result, err = s.db.ExecContext(ctx, query, occ.ID)
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to mark enqueued: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return n > 0, nil
+}
+
+// GetOutbox returns up to limit notifications not published yet, the oldest first, all when limit is not positive.
+func (s *Storage) GetOutbox(ctx context.Context, limit int) ([]model.OutboxEntry, error) {
+	query := `SELECT id, dedupkey, eventid, userid, title, date, created, deliveredat, attempts, lasterror
+	          FROM outbox WHERE sentat IS NULL ORDER BY id`
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get outbox: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []model.OutboxEntry{}
+	for rows.Next() {
+		var entry model.OutboxEntry
+		var title, lastError sql.NullString
+		var delivered sql.NullTime
+		if err := rows.Scan(&entry.ID, &entry.Msg.Key, &entry.Msg.ID, &entry.Msg.UserID, &title, &entry.Msg.Date,
+			&entry.Created, &delivered, &entry.Attempts, &lastError); err != nil {
+			return nil, fmt.Errorf("failed to rows.Scan: %w", err)
+		}
+		entry.Msg.Title = title.String
+		entry.Msg.Date = entry.Msg.Date.UTC()
+		entry.Created = entry.Created.UTC()
+		if delivered.Valid {
+			entry.Delivered = delivered.Time.UTC()
+		}
+		entry.Error = lastError.String
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to rows.Err: %w", err)
+	}
+	return entries, nil
+}
+
+func (s *Storage) MarkOutboxSent(ctx context.Context, id int64) error {
+	query := `UPDATE outbox SET sentat = $2 WHERE id = $1`
+	if _, err := s.db.ExecContext(ctx, query, id, time.Now().UTC()); err != nil {
+		return fmt.Errorf("failed to mark outbox sent: %w", err)
+	}
+	return nil
+}
+
+// MarkOutboxFailed counts a failed publishing of a notification and keeps its reason.
+func (s *Storage) MarkOutboxFailed(ctx context.Context, id int64, reason string) error {
+	query := `UPDATE outbox SET attempts = attempts + 1, lasterror = $2 WHERE id = $1`
+	if _, err := s.db.ExecContext(ctx, query, id, reason); err != nil {
+		return fmt.Errorf("failed to mark outbox failed: %w", err)
+	}
+	return nil
+}
+
+// PurgeOutbox removes the notifications published before date and the deliveries recorded before date.
+func (s *Storage) PurgeOutbox(ctx context.Context, date time.Time) (int64, error) {
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM deliveries WHERE deliveredat < $1`, date.UTC()); err != nil {
+		return 0, fmt.Errorf("failed to purge deliveries: %w", err)
+	}
+	query := `DELETE FROM outbox WHERE sentat < $1`
+	result, err := s.db.ExecContext(ctx, query, date.UTC())
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge outbox: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rowsAffected, nil
+}
+
+// IsNotificationDelivered reports whether the notification of key was delivered,
+// the key of a notification or of its delivery through one channel.
+func (s *Storage) IsNotificationDelivered(ctx context.Context, key string) (bool, error) {
+	var found string
+	query := `SELECT dedupkey FROM deliveries WHERE dedupkey = $1`
+	if err := s.db.QueryRowContext(ctx, query, key).Scan(&found); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get delivery: %w", err)
+	}
+	return true, nil
+}
+
+// MarkNotificationDelivered marks the notification of key delivered, with its outbox entry if there is one.
+func (s *Storage) MarkNotificationDelivered(ctx context.Context, key string) error {
+	now := time.Now().UTC()
+	return s.inTx(ctx, func(tx *Storage) error {
+		query := `INSERT INTO deliveries (dedupkey, deliveredat) VALUES ($1, $2) ON CONFLICT (dedupkey) DO NOTHING`
+		if _, err := tx.db.ExecContext(ctx, query, key, now); err != nil {
+			return fmt.Errorf("failed to insert delivery: %w", err)
+		}
+		query = `UPDATE outbox SET deliveredat = $2 WHERE dedupkey = $1 AND deliveredat IS NULL`
+		if _, err := tx.db.ExecContext(ctx, query, key, now); err != nil {
+			return fmt.Errorf("failed to mark outbox delivered: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,124 @@
+package sqlstorage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/jmoiron/sqlx"
+)
+
+// GetEventsOlderDate returns the events ended before date, the oldest first, at most limit of them
+// when limit is positive. A series ends with its last occurrence, endless series never end.
+func (s *Storage) GetEventsOlderDate(ctx context.Context, date time.Time, limit int) ([]model.Event, error) {
+	var eSQL EventSQL
+	events := []model.Event{}
+
+	query := `SELECT id, userid, calendarid, title, description, ontime, offtime, notifytime, timezone,
+	          rrule, exdates, overrides, status, version
+	          FROM events
+	          WHERE deletedat IS NULL AND rrule IS NULL AND offtime < $1
+	          ORDER BY offtime, id`
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, date.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed lookup old events: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := rows.Scan(&eSQL.ID, &eSQL.UserID, &eSQL.CalendarID, &eSQL.Title, &eSQL.Description,
+			&eSQL.OnTime, &eSQL.OffTime, &eSQL.NotifyTime, &eSQL.TimeZone,
+			&eSQL.RRule, &eSQL.ExDates, &eSQL.Overrides, &eSQL.Status, &eSQL.Version); err != nil {
+			return nil, fmt.Errorf("failed rows.Scan: %w", err)
+		}
+		events = append(events, ConvertSQLEventToStorageEvent(eSQL))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed lookup old events: %w", err)
+	}
+
+	recurring, err := s.getRecurringEvents(ctx, `offtime < $1`, date.UTC())
+	if err != nil {
+		return nil, err
+	}
+	for i := range recurring {
+		if last, ok := recurring[i].LastOffTime(); ok && last.Before(date) {
+			events = append(events, recurring[i])
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		last, _ := events[i].LastOffTime()
+		other, _ := events[j].LastOffTime()
+		return last.Before(other)
+	})
+	if limit > 0 && len(events) > limit {
+		events = events[:limit]
+	}
+	return events, s.loadAttendees(ctx, events)
+}
+
+// DeleteEvents moves events to the trash with one statement and returns how many were moved,
+// missing events are skipped.
+func (s *Storage) DeleteEvents(ctx context.Context, ids []int64) (int64, error) {
+	var deleted int64
+	err := s.inTx(ctx, func(tx *Storage) error {
+		before := make([]*model.Event, 0, len(ids))
+		for _, id := range ids {
+			e, err := tx.eventState(ctx, id, false)
+			if err != nil {
+				if err == ErrEventNotFound { //nolint:errorlint
+					continue
+				}
+				return err
+			}
+			before = append(before, e)
+		}
+		if len(before) == 0 {
+			return nil
+		}
+
+		query, args, err := sqlx.In(`UPDATE events SET deletedat = ? WHERE deletedat IS NULL AND id IN (?)`,
+			time.Now().UTC(), ids)
+		if err != nil {
+			return fmt.Errorf("failed to build delete query: %w", err)
+		}
+		result, err := tx.db.ExecContext(ctx, tx.db.Rebind(query), args...)
+		if err != nil {
+			return fmt.Errorf("failed to delete events: %w", err)
+		}
+		if deleted, err = result.RowsAffected(); err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+
+		for _, e := range before {
+			if err := tx.record(ctx, model.AuditDelete, e.ID, e, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return deleted, nil
+}
+
+// DeleteEventsOlderDate moves all the events ended before date to the trash.
+func (s *Storage) DeleteEventsOlderDate(ctx context.Context, date time.Time) (int64, error) {
+	events, err := s.GetEventsOlderDate(ctx, date, 0)
+	if err != nil {
+		return 0, err
+	}
+	ids := make([]int64, 0, len(events))
+	for i := range events {
+		ids = append(ids, events[i].ID)
+	}
+	return s.DeleteEvents(ctx, ids)
+}
@@ -0,0 +1,35 @@
+package sqlstorage
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	_ "modernc.org/sqlite" // pure Go SQLite driver, no cgo needed
+)
+
+const driverSQLite = "sqlite"
+
+// sqlitePragmas turn on the foreign keys the cascades rely on, wait for the lock of concurrent writers
+// and store times in a format SQLite and the driver both understand.
+var sqlitePragmas = []string{
+	"_pragma=foreign_keys(1)",
+	"_pragma=busy_timeout(5000)",
+	"_pragma=journal_mode(WAL)",
+	"_time_format=sqlite",
+}
+
+// NewSQLite returns a storage keeping events in the SQLite database file of dsn,
+// a path or a "file:" URI. The file is created and migrated on Connect.
+func NewSQLite(dsn string) *Storage {
+	sep := "?"
+	if strings.Contains(dsn, "?") {
+		sep = "&"
+	}
+	return &Storage{driver: driverSQLite, dsn: dsn + sep + strings.Join(sqlitePragmas, "&")}
+}
+
+// migrateSQLite applies the pending SQLite migrations, there is no database server to migrate beforehand.
+func (s *Storage) migrateSQLite(ctx context.Context) error {
+	return s.Migrate(ctx, "up", io.Discard)
+}
@@ -0,0 +1,229 @@
+package sqlstorage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/migrations"
+	"github.com/stretchr/testify/require"
+)
+
+func newSQLite(t *testing.T) *Storage {
+	t.Helper()
+	s := NewSQLite(filepath.Join(t.TempDir(), "calendar.db"))
+	require.NoError(t, s.Connect(context.Background()))
+	t.Cleanup(func() { s.Close(context.Background()) })
+	return s
+}
+
+func TestSQLiteEvents(t *testing.T) {
+	s := newSQLite(t)
+	ctx := context.Background()
+	msk := time.FixedZone("MSK", 3*60*60)
+	onTime := time.Date(2024, 1, 1, 10, 0, 0, 0, msk)
+
+	e := &model.Event{
+		UserID: 1, Title: "long", OnTime: onTime, OffTime: onTime.Add(8 * time.Hour),
+		NotifyTime: onTime.Add(-time.Hour), TimeZone: "Europe/Moscow", Status: model.EventStatusTentative,
+	}
+	require.NoError(t, s.InsertEvent(ctx, e))
+	require.NotZero(t, e.ID)
+
+	stored, err := s.GetEventByID(ctx, e.ID)
+	require.NoError(t, err)
+	require.Equal(t, "long", stored.Title)
+	require.True(t, onTime.Equal(stored.OnTime))
+	require.Equal(t, model.EventStatusTentative, stored.Status)
+
+	// times given in other zones compare as instants
+	inside := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	events, err := s.GetAllRange(ctx, 1, inside, inside.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	overlaps, err := s.GetOverlaps(ctx, 0, 1, inside, inside.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, overlaps, 1)
+	overlaps, err = s.GetOverlaps(ctx, 0, 1, stored.OffTime, stored.OffTime.Add(time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, overlaps)
+
+	e.Title = "changed"
+	require.NoError(t, s.UpdateEvent(ctx, e))
+	events, err = s.GetAllEvents(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "changed", events[0].Title)
+
+	notices, err := s.GetEventsDayOfNotice(ctx, onTime)
+	require.NoError(t, err)
+	require.Len(t, notices, 1)
+	require.NoError(t, s.UpdateEventNotified(ctx, e.ID))
+	notices, err = s.GetEventsDayOfNotice(ctx, onTime)
+	require.NoError(t, err)
+	require.Empty(t, notices)
+
+	deleted, err := s.DeleteEventsOlderDate(ctx, onTime)
+	require.NoError(t, err)
+	require.Zero(t, deleted)
+	deleted, err = s.DeleteEventsOlderDate(ctx, onTime.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), deleted)
+	_, err = s.GetEventByID(ctx, e.ID)
+	require.ErrorIs(t, err, ErrEventNotFound)
+	require.ErrorIs(t, s.DeleteEvent(ctx, e.ID), ErrEventNotFound)
+}
+
+func TestSQLiteRecurring(t *testing.T) {
+	s := newSQLite(t)
+	ctx := context.Background()
+	onTime := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	e := &model.Event{
+		UserID: 1, Title: "stand-up", OnTime: onTime, OffTime: onTime.Add(30 * time.Minute),
+		NotifyTime: onTime.Add(-15 * time.Minute), RRule: "FREQ=DAILY;COUNT=3",
+		ExDates: []time.Time{onTime.AddDate(0, 0, 1)},
+	}
+	require.NoError(t, s.InsertEvent(ctx, e))
+
+	events, err := s.GetAllRange(ctx, 1, onTime, onTime.AddDate(0, 0, 7))
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	date := onTime.AddDate(0, 0, 2).Add(-10 * time.Minute)
+	notices, err := s.GetEventsDayOfNotice(ctx, date)
+	require.NoError(t, err)
+	require.Len(t, notices, 1)
+	require.True(t, onTime.AddDate(0, 0, 2).Equal(notices[0].OnTime))
+}
+
+func TestSQLiteCalendars(t *testing.T) {
+	s := newSQLite(t)
+	ctx := context.Background()
+	onTime := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	require.NoError(t, s.UpsertUser(ctx, &model.User{ID: 1, TimeZone: "Europe/Berlin"}))
+	user, err := s.GetUser(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, "Europe/Berlin", user.TimeZone)
+
+	c := &model.Calendar{OwnerID: 1, Name: "work", ConflictPolicy: model.ConflictPolicyWarn}
+	require.NoError(t, s.InsertCalendar(ctx, c))
+	require.NoError(t, s.UpsertCalendarShare(ctx, &model.CalendarShare{
+		CalendarID: c.ID, UserID: 2, Permission: model.PermissionRead,
+	}))
+	calendars, err := s.GetCalendars(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, calendars, 1)
+	require.Equal(t, model.PermissionRead, calendars[0].Permission)
+	require.Equal(t, model.ConflictPolicyWarn, calendars[0].ConflictPolicy)
+
+	e := &model.Event{UserID: 1, CalendarID: c.ID, Title: "review", OnTime: onTime, OffTime: onTime.Add(time.Hour)}
+	require.NoError(t, s.InsertEvent(ctx, e))
+	require.NoError(t, s.SetAttendee(ctx, e.ID, model.Attendee{UserID: 3, Status: model.PartStatAccepted}))
+	events, err := s.GetAllRange(ctx, 3, onTime, onTime.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Len(t, events[0].Attendees, 1)
+	invitations, err := s.GetInvitations(ctx, 3)
+	require.NoError(t, err)
+	require.Len(t, invitations, 1)
+
+	// deleting a calendar cascades to its events, shares and attendees
+	require.NoError(t, s.DeleteCalendar(ctx, c.ID))
+	_, err = s.GetEventByID(ctx, e.ID)
+	require.ErrorIs(t, err, ErrEventNotFound)
+	_, err = s.GetCalendarShare(ctx, c.ID, 2)
+	require.ErrorIs(t, err, ErrShareNotFound)
+	invitations, err = s.GetInvitations(ctx, 3)
+	require.NoError(t, err)
+	require.Empty(t, invitations)
+
+	token := &model.FeedToken{Token: "secret", UserID: 1, Created: onTime}
+	require.NoError(t, s.InsertFeedToken(ctx, token))
+	tokens, err := s.GetFeedTokens(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	require.NoError(t, s.DeleteFeedToken(ctx, "secret"))
+	require.ErrorIs(t, s.DeleteFeedToken(ctx, "secret"), ErrFeedTokenNotFound)
+}
+
+// TestSQLiteAuditTransaction checks that a change is rolled back when its audit entry can't be written.
+func TestSQLiteAuditTransaction(t *testing.T) {
+	s := newSQLite(t)
+	ctx := context.Background()
+	onTime := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	e := &model.Event{UserID: 1, Title: "audited", OnTime: onTime, OffTime: onTime.Add(time.Hour)}
+	require.NoError(t, s.InsertEvent(ctx, e))
+
+	_, err := s.db.ExecContext(ctx, `CREATE TRIGGER audit_down BEFORE INSERT ON event_audit
+	                                 BEGIN SELECT RAISE(ABORT, 'audit down'); END`)
+	require.NoError(t, err)
+	e.Title = "changed"
+	require.Error(t, s.UpdateEvent(ctx, e))
+	require.Error(t, s.DeleteEvent(ctx, e.ID))
+	require.Error(t, s.SetAttendee(ctx, e.ID, model.Attendee{UserID: 2, Status: model.PartStatAccepted}))
+
+	stored, err := s.GetEventByID(ctx, e.ID)
+	require.NoError(t, err)
+	require.Equal(t, "audited", stored.Title)
+	require.Equal(t, int64(1), stored.Version)
+	require.Empty(t, stored.Attendees)
+}
+
+func TestSQLiteReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "calendar.db")
+	ctx := context.Background()
+	onTime := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	s := NewSQLite(path)
+	require.NoError(t, s.Connect(ctx))
+	e := &model.Event{UserID: 1, Title: "kept", OnTime: onTime, OffTime: onTime.Add(time.Hour)}
+	require.NoError(t, s.InsertEvent(ctx, e))
+	require.NoError(t, s.Close(ctx))
+
+	s = NewSQLite(path)
+	require.NoError(t, s.Connect(ctx))
+	defer s.Close(ctx)
+	stored, err := s.GetEventByID(ctx, e.ID)
+	require.NoError(t, err)
+	require.Equal(t, "kept", stored.Title)
+}
+
+func TestSQLiteMigrations(t *testing.T) {
+	s := newSQLite(t)
+	ctx := context.Background()
+	require.NoError(t, s.CheckSchema(ctx))
+
+	var out bytes.Buffer
+	require.NoError(t, s.Migrate(ctx, "down", &out))
+	require.Contains(t, out.String(), "OK    down")
+	require.ErrorIs(t, s.CheckSchema(ctx), ErrSchemaOutdated)
+
+	out.Reset()
+	require.NoError(t, s.Migrate(ctx, "status", &out))
+	require.Contains(t, out.String(), "Pending")
+
+	require.NoError(t, s.Migrate(ctx, "up", io.Discard))
+	require.NoError(t, s.CheckSchema(ctx))
+	require.ErrorIs(t, s.Migrate(ctx, "reset", io.Discard), ErrMigrateCommand)
+}
+
+// TestMigrationVersions guards against a migration added to one set of migrations only.
+func TestMigrationVersions(t *testing.T) {
+	last := func(pattern string) string {
+		files, err := fs.Glob(migrations.FS, pattern)
+		require.NoError(t, err)
+		require.NotEmpty(t, files)
+		sort.Strings(files)
+		return strings.SplitN(path.Base(files[len(files)-1]), "_", 2)[0]
+	}
+	require.Equal(t, last("*.sql"), last("sqlite/*.sql"))
+}
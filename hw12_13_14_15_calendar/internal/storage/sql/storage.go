@@ -3,33 +3,55 @@ package sqlstorage
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/storage/storageerr"
 	_ "github.com/jackc/pgx/stdlib"
 	"github.com/jmoiron/sqlx"
 )
 
+// Storage keeps events in PostgreSQL, or in SQLite when created by NewSQLite.
+// Times are bound in UTC, so they compare as instants whatever zone the caller used.
+// Deleted events stay in the table with deletedat set until they are purged, queries skip them.
 type Storage struct {
-	dsn string
-	db  *sqlx.DB
+	driver string
+	dsn    string
+	pool   *sqlx.DB
+	db     dbtx
 }
 
 var (
-	ErrEventNotFound = errors.New("event not found")
-	ErrDateBusy      = errors.New("data is busy")
+	ErrEventNotFound     = storageerr.ErrEventNotFound
+	ErrFeedTokenNotFound = storageerr.ErrFeedTokenNotFound
+	ErrCalendarNotFound  = storageerr.ErrCalendarNotFound
+	ErrShareNotFound     = storageerr.ErrShareNotFound
+	ErrAttendeeNotFound  = storageerr.ErrAttendeeNotFound
+	ErrVersionConflict   = storageerr.ErrVersionConflict
 )
 
 type EventSQL struct {
-	ID          sql.NullInt64
-	UserID      sql.NullInt64
-	Title       sql.NullString
-	Description sql.NullString
-	OnTime      sql.NullTime
-	OffTime     sql.NullTime
-	NotifyTime  sql.NullTime
+	ID           sql.NullInt64
+	UserID       sql.NullInt64
+	CalendarID   sql.NullInt64
+	Title        sql.NullString
+	Description  sql.NullString
+	OnTime       sql.NullTime
+	OffTime      sql.NullTime
+	NotifyTime   sql.NullTime
+	TimeZone     sql.NullString
+	RRule        sql.NullString
+	ExDates      sql.NullString
+	Overrides    sql.NullString
+	LastNotified sql.NullTime
+	LastEnqueued sql.NullTime
+	Status       sql.NullString
+	Version      sql.NullInt64
+	DeletedAt    sql.NullTime
 }
 
 func ConvertSQLEventToStorageEvent(e EventSQL) (event model.Event) {
@@ -41,6 +63,10 @@ func ConvertSQLEventToStorageEvent(e EventSQL) (event model.Event) {
 		event.UserID = e.UserID.Int64
 	}
 
+	if e.CalendarID.Valid {
+		event.CalendarID = e.CalendarID.Int64
+	}
+
 	if e.Title.Valid {
 		event.Title = e.Title.String
 	}
@@ -60,28 +86,65 @@ func ConvertSQLEventToStorageEvent(e EventSQL) (event model.Event) {
 	if e.NotifyTime.Valid {
 		event.NotifyTime = e.NotifyTime.Time
 	}
+
+	if e.TimeZone.Valid {
+		event.TimeZone = e.TimeZone.String
+	}
+
+	if e.RRule.Valid {
+		event.RRule = e.RRule.String
+	}
+
+	if e.ExDates.Valid {
+		_ = json.Unmarshal([]byte(e.ExDates.String), &event.ExDates)
+	}
+
+	if e.Overrides.Valid {
+		_ = json.Unmarshal([]byte(e.Overrides.String), &event.Overrides)
+	}
+
+	if e.LastNotified.Valid {
+		event.LastNotified = e.LastNotified.Time
+	}
+
+	if e.LastEnqueued.Valid {
+		event.LastEnqueued = e.LastEnqueued.Time
+	}
+
+	if e.Status.Valid {
+		event.Status = model.EventStatus(e.Status.String)
+	}
+	if e.Version.Valid {
+		event.Version = e.Version.Int64
+	}
+	if e.DeletedAt.Valid {
+		event.DeletedAt = e.DeletedAt.Time
+	}
 	return event
 }
 
 func New(dsn string) *Storage {
-	return &Storage{dsn: dsn}
+	return &Storage{driver: "pgx", dsn: dsn}
 }
 
 func (s *Storage) Connect(ctx context.Context) error {
-	db, err := sqlx.Open("pgx", s.dsn)
+	db, err := sqlx.Open(s.driver, s.dsn)
 	if err != nil {
 		return fmt.Errorf("failed to load driver: %w", err)
 	}
-	s.db = db
-	err = s.db.PingContext(ctx)
+	s.pool, s.db = db, db
+	err = s.pool.PingContext(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to connect to db: %w", err)
 	}
+	if s.driver == driverSQLite {
+		return s.migrateSQLite(ctx)
+	}
 	return nil
 }
 
 func (s *Storage) Close(ctx context.Context) error {
-	s.db.Close()
+	s.pool.Close()
 	ctx.Done()
 	return nil
 }
@@ -90,7 +153,7 @@ func timeNull(t time.Time) sql.NullTime {
 	if t.IsZero() {
 		return sql.NullTime{}
 	}
-	return sql.NullTime{Time: t, Valid: true}
+	return sql.NullTime{Time: t.UTC(), Valid: true}
 }
 
 func stringNull(s string) sql.NullString {
@@ -100,83 +163,162 @@ func stringNull(s string) sql.NullString {
 	return sql.NullString{String: s, Valid: true}
 }
 
-func (s *Storage) InsertEvent(ctx context.Context, e *model.Event) error {
-	query := `INSERT INTO events (userid, title, description, ontime, offtime, notifytime)
-							VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`
-	rows, err := s.db.QueryxContext(ctx, query, e.UserID, stringNull(e.Title), stringNull(e.Description),
-		timeNull(e.OnTime), timeNull(e.OffTime), timeNull(e.NotifyTime))
+func int64Null(i int64) sql.NullInt64 {
+	if i == 0 {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: i, Valid: true}
+}
+
+func jsonNull[T any](v []T) sql.NullString {
+	if len(v) == 0 {
+		return sql.NullString{}
+	}
+	data, err := json.Marshal(v)
 	if err != nil {
-		return fmt.Errorf("failed to insert event: %w", err)
+		return sql.NullString{}
 	}
-	defer rows.Close()
+	return sql.NullString{String: string(data), Valid: true}
+}
 
-	for rows.Next() {
-		err = rows.Scan(&e.ID)
+// InsertEvent stores an event with its attendees at version 1.
+func (s *Storage) InsertEvent(ctx context.Context, e *model.Event) error {
+	return s.inTx(ctx, func(tx *Storage) error {
+		query := `INSERT INTO events (userid, title, description, ontime, offtime, notifytime,
+								rrule, exdates, overrides, timezone, calendarid, status)
+								VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING id, version`
+		rows, err := tx.db.QueryxContext(ctx, query, e.UserID, stringNull(e.Title), stringNull(e.Description),
+			timeNull(e.OnTime), timeNull(e.OffTime), timeNull(e.NotifyTime),
+			stringNull(e.RRule), jsonNull(e.ExDates), jsonNull(e.Overrides), stringNull(e.TimeZone),
+			int64Null(e.CalendarID), stringNull(string(e.Status)))
 		if err != nil {
-			return fmt.Errorf("failed to rows.Scan: %w", err)
+			return fmt.Errorf("failed to insert event: %w", err)
 		}
-	}
+		defer rows.Close()
 
-	if err := rows.Err(); err != nil {
-		return fmt.Errorf("failed to rows.Err: %w", err)
-	}
+		for rows.Next() {
+			err = rows.Scan(&e.ID, &e.Version)
+			if err != nil {
+				return fmt.Errorf("failed to rows.Scan: %w", err)
+			}
+		}
 
-	return nil
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("failed to rows.Err: %w", err)
+		}
+
+		for _, a := range e.Attendees {
+			if _, err := tx.db.ExecContext(ctx, `INSERT INTO attendees (eventid, userid, status) VALUES ($1, $2, $3)`,
+				e.ID, a.UserID, string(a.Status)); err != nil {
+				return fmt.Errorf("failed to insert attendee: %w", err)
+			}
+		}
+		sort.Slice(e.Attendees, func(i, j int) bool { return e.Attendees[i].UserID < e.Attendees[j].UserID })
+
+		return tx.record(ctx, model.AuditInsert, e.ID, nil, e)
+	})
 }
 
+// UpdateEvent replaces an event, the attendees are kept as they are changed by their own methods.
+// A non-zero version of e must be the stored one, the version is incremented.
 func (s *Storage) UpdateEvent(ctx context.Context, e *model.Event) error {
-	query := `UPDATE events SET userid=$2, 
-                  				title=$3, 
-								description=$4, 
-                  				ontime=$5, 
-                  				offtime=$6, 
-                  				notifytime=$7 
-              WHERE id=$1`
-	res, err := s.db.ExecContext(ctx, query, e.ID, e.UserID, stringNull(e.Title), stringNull(e.Description),
-		timeNull(e.OnTime), timeNull(e.OffTime), timeNull(e.NotifyTime))
-	if err != nil {
-		return fmt.Errorf("failed to update event: %w", err)
-	}
-
-	ra, err := res.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get RowsAffected: %w", err)
-	}
+	return s.inTx(ctx, func(tx *Storage) error {
+		before, err := tx.eventState(ctx, e.ID, false)
+		if err != nil {
+			return err
+		}
 
-	if ra != 1 {
-		return fmt.Errorf("failed to update event: %v", ra)
-	}
+		query := `UPDATE events SET userid=$2, 
+	                  				title=$3, 
+									description=$4, 
+	                  				ontime=$5, 
+	                  				offtime=$6, 
+	                  				notifytime=$7, 
+	                  				rrule=$8, 
+	                  				exdates=$9, 
+	                  				overrides=$10, 
+	                  				timezone=$11, 
+	                  				calendarid=$12, 
+	                  				status=$13, 
+	                  				version=version + 1 
+	              WHERE id=$1 AND deletedat IS NULL AND (CAST($14 AS BIGINT) = 0 OR version = $14) 
+	              RETURNING version`
+		var version int64
+		err = tx.db.QueryRowContext(ctx, query, e.ID, e.UserID, stringNull(e.Title), stringNull(e.Description),
+			timeNull(e.OnTime), timeNull(e.OffTime), timeNull(e.NotifyTime),
+			stringNull(e.RRule), jsonNull(e.ExDates), jsonNull(e.Overrides), stringNull(e.TimeZone),
+			int64Null(e.CalendarID), stringNull(string(e.Status)), e.Version).Scan(&version)
+		if errors.Is(err, sql.ErrNoRows) {
+			return tx.missingEventOr(ctx, e.ID, ErrVersionConflict)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update event: %w", err)
+		}
+		e.Version = version
 
-	return nil
+		return tx.recordUpdate(ctx, model.AuditUpdate, before)
+	})
 }
 
+// DeleteEvent moves an event to the trash.
 func (s *Storage) DeleteEvent(ctx context.Context, id int64) error {
-	query := `DELETE FROM events WHERE id=$1`
-	if _, err := s.db.ExecContext(ctx, query, id); err != nil {
-		return fmt.Errorf("failed to delete event: %w", err)
-	}
-	return nil
+	return s.inTx(ctx, func(tx *Storage) error {
+		before, err := tx.eventState(ctx, id, false)
+		if err != nil {
+			return err
+		}
+
+		query := `UPDATE events SET deletedat=$2 WHERE id=$1 AND deletedat IS NULL`
+		res, err := tx.db.ExecContext(ctx, query, id, time.Now().UTC())
+		if err != nil {
+			return fmt.Errorf("failed to delete event: %w", err)
+		}
+
+		ra, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get RowsAffected: %w", err)
+		}
+		if ra == 0 {
+			return ErrEventNotFound
+		}
+		return tx.record(ctx, model.AuditDelete, id, before, nil)
+	})
 }
 
+// GetAllRange returns the events of a user in [begin, end), with the events the user accepted as an attendee.
 func (s *Storage) GetAllRange(ctx context.Context, userID int64, begin, end time.Time) ([]model.Event, error) {
+	return s.getRange(ctx, `(userid = $1 OR id IN
+	          (SELECT eventid FROM attendees WHERE userid = $1 AND status = 'accepted'))`, userID, begin, end)
+}
+
+// GetCalendarRange returns the events of a calendar in [begin, end).
+func (s *Storage) GetCalendarRange(ctx context.Context, calendarID int64, begin, end time.Time) ([]model.Event, error) {
+	return s.getRange(ctx, `calendarid = $1`, calendarID, begin, end)
+}
+
+// getRange returns the events in a range matching the where clause of value, recurring events are expanded.
+func (s *Storage) getRange(ctx context.Context, where string, value int64,
+	begin, end time.Time,
+) ([]model.Event, error) {
 	var e model.Event
-	var events []model.Event
 	var eSQL EventSQL
+	events := []model.Event{}
 
-	query := `SELECT id, userid, title, description, ontime, offtime, notifytime
+	query := `SELECT id, userid, calendarid, title, description, ontime, offtime, notifytime, timezone,
+	          rrule, exdates, overrides, status, version
 	          FROM events
-			  WHERE userid = $1 AND 
-			  (ontime BETWEEN $2 AND $3 OR offtime BETWEEN $2 AND $3)`
+			  WHERE ` + where + ` AND deletedat IS NULL AND rrule IS NULL AND ontime < $3 AND offtime > $2`
 
-	rows, err := s.db.QueryContext(ctx, query, userID, begin, end)
+	rows, err := s.db.QueryContext(ctx, query, value, begin.UTC(), end.UTC())
 	if err != nil {
 		return events, fmt.Errorf("failed lookup event: %w", err)
 	}
 	defer rows.Close()
 
 	for rows.Next() {
-		if err := rows.Scan(&eSQL.ID, &eSQL.UserID, &eSQL.Title, &eSQL.Description,
-			&eSQL.OnTime, &eSQL.OffTime, &eSQL.NotifyTime); err != nil {
+		if err := rows.Scan(&eSQL.ID, &eSQL.UserID, &eSQL.CalendarID, &eSQL.Title, &eSQL.Description,
+			&eSQL.OnTime, &eSQL.OffTime, &eSQL.NotifyTime, &eSQL.TimeZone,
+			&eSQL.RRule, &eSQL.ExDates, &eSQL.Overrides, &eSQL.Status, &eSQL.Version); err != nil {
 			return events, fmt.Errorf("failed rows.Scan: %w", err)
 		}
 		e = ConvertSQLEventToStorageEvent(eSQL)
@@ -187,18 +329,77 @@ func (s *Storage) GetAllRange(ctx context.Context, userID int64, begin, end time
 		return events, fmt.Errorf("failed lookup event: %w", err)
 	}
 
+	recurring, err := s.getRecurringEvents(ctx, where+` AND ontime < $2`, value, end.UTC())
+	if err != nil {
+		return events, err
+	}
+	for i := range recurring {
+		occurrences, err := recurring[i].Occurrences(begin, end)
+		if err != nil {
+			return events, fmt.Errorf("failed expand event %v: %w", recurring[i].ID, err)
+		}
+		events = append(events, occurrences...)
+	}
+
+	return events, s.loadAttendees(ctx, events)
+}
+
+// getRecurringEvents returns the recurring events matching the where clause.
+func (s *Storage) getRecurringEvents(ctx context.Context, where string, args ...interface{}) ([]model.Event, error) {
+	var events []model.Event
+	var eSQL EventSQL
+
+	query := `SELECT id, userid, calendarid, title, description, ontime, offtime, notifytime, timezone,
+	          rrule, exdates, overrides, status, version,
+	          lastnotified, lastenqueued
+	          FROM events
+			  WHERE deletedat IS NULL AND rrule IS NOT NULL AND ` + where
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return events, fmt.Errorf("failed lookup recurring event: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := rows.Scan(&eSQL.ID, &eSQL.UserID, &eSQL.CalendarID, &eSQL.Title, &eSQL.Description,
+			&eSQL.OnTime, &eSQL.OffTime, &eSQL.NotifyTime, &eSQL.TimeZone,
+			&eSQL.RRule, &eSQL.ExDates, &eSQL.Overrides, &eSQL.Status, &eSQL.Version, &eSQL.LastNotified,
+			&eSQL.LastEnqueued); err != nil {
+			return events, fmt.Errorf("failed rows.Scan: %w", err)
+		}
+		events = append(events, ConvertSQLEventToStorageEvent(eSQL))
+	}
+
+	if err := rows.Err(); err != nil {
+		return events, fmt.Errorf("failed lookup recurring event: %w", err)
+	}
+
 	return events, nil
 }
 
-func (s *Storage) GetEventByID(ctx context.Context, eID int64) (e model.Event, err error) {
+func (s *Storage) GetEventByID(ctx context.Context, eID int64) (model.Event, error) {
+	return s.getEvent(ctx, eID, false)
+}
+
+// getEvent returns a stored event, or a trashed one when trashed is set.
+func (s *Storage) getEvent(ctx context.Context, eID int64, trashed bool) (e model.Event, err error) {
 	var eventSQL EventSQL
-	query := `SELECT id, userid, title, description, ontime, offtime, notifytime
-	          FROM events WHERE id = $1`
+	deleted := "deletedat IS NULL"
+	if trashed {
+		deleted = "deletedat IS NOT NULL"
+	}
+	query := `SELECT id, userid, calendarid, title, description, ontime, offtime, notifytime, timezone,
+	          rrule, exdates, overrides, status, version, deletedat
+	          FROM events WHERE id = $1 AND ` + deleted
 
 	rows := s.db.QueryRowContext(ctx, query, eID)
 
-	if err := rows.Scan(&eventSQL.ID, &eventSQL.UserID, &eventSQL.Title, &eventSQL.Description,
-		&eventSQL.OnTime, &eventSQL.OffTime, &eventSQL.NotifyTime); err != nil {
+	if err := rows.Scan(&eventSQL.ID, &eventSQL.UserID, &eventSQL.CalendarID, &eventSQL.Title,
+		&eventSQL.Description,
+		&eventSQL.OnTime, &eventSQL.OffTime, &eventSQL.NotifyTime, &eventSQL.TimeZone,
+		&eventSQL.RRule, &eventSQL.ExDates, &eventSQL.Overrides, &eventSQL.Status, &eventSQL.Version,
+		&eventSQL.DeletedAt); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return e, ErrEventNotFound
 		}
@@ -210,17 +411,23 @@ func (s *Storage) GetEventByID(ctx context.Context, eID int64) (e model.Event, e
 	}
 
 	e = ConvertSQLEventToStorageEvent(eventSQL)
+	events := []model.Event{e}
+	if err := s.loadAttendees(ctx, events); err != nil {
+		return e, err
+	}
 
-	return e, err
+	return events[0], nil
 }
 
 func (s *Storage) GetAllEvents(ctx context.Context, userID int64) (events []model.Event, err error) {
 	var e model.Event
 	var eSQL EventSQL
+	events = []model.Event{}
 
-	query := `SELECT id, userid, title, description, ontime, offtime, notifytime
-			  FROM events WHERE userid=$1`
-	rows, err := s.db.Queryx(query, userID)
+	query := `SELECT id, userid, calendarid, title, description, ontime, offtime, notifytime, timezone,
+	          rrule, exdates, overrides, status, version
+			  FROM events WHERE userid=$1 AND deletedat IS NULL`
+	rows, err := s.db.QueryxContext(ctx, query, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get events: %w", err)
 	}
@@ -238,51 +445,79 @@ func (s *Storage) GetAllEvents(ctx context.Context, userID int64) (events []mode
 		return nil, fmt.Errorf("failed to rows.Err: %w", err)
 	}
 
-	return events, nil
+	return events, s.loadAttendees(ctx, events)
 }
 
-func (s *Storage) IsBusyDateTimeRange(ctx context.Context, id, userID int64, onTime, offTime time.Time) error {
+// GetOverlaps returns the occurrences of the other events of a user overlapping [onTime, offTime),
+// events sharing only an endpoint with the range don't overlap it.
+func (s *Storage) GetOverlaps(ctx context.Context, id, userID int64, onTime, offTime time.Time) ([]model.Event, error) {
 	var eSQL EventSQL
-	query := `SELECT id
+	overlaps := []model.Event{}
+	query := `SELECT id, userid, calendarid, title, description, ontime, offtime, notifytime, timezone,
+	          rrule, exdates, overrides, status, version
 	          FROM events
-			  WHERE id != $1 AND userid = $2 AND
-			  (($3 BETWEEN ontime and offtime) OR
-			   ($4 BETWEEN ontime and offtime))`
+			  WHERE id != $1 AND userid = $2 AND deletedat IS NULL AND rrule IS NULL AND ontime < $4 AND offtime > $3`
 
-	rows := s.db.QueryRowContext(ctx, query, id, userID, onTime, offTime)
+	rows, err := s.db.QueryContext(ctx, query, id, userID, onTime.UTC(), offTime.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed lookup event: %w", err)
+	}
+	defer rows.Close()
 
-	if err := rows.Scan(&eSQL.ID); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil
+	for rows.Next() {
+		if err := rows.Scan(&eSQL.ID, &eSQL.UserID, &eSQL.CalendarID, &eSQL.Title, &eSQL.Description,
+			&eSQL.OnTime, &eSQL.OffTime, &eSQL.NotifyTime, &eSQL.TimeZone,
+			&eSQL.RRule, &eSQL.ExDates, &eSQL.Overrides, &eSQL.Status, &eSQL.Version); err != nil {
+			return nil, fmt.Errorf("failed rows.Scan: %w", err)
 		}
-		return fmt.Errorf("failed rows.Scan: %w", err)
+		overlaps = append(overlaps, ConvertSQLEventToStorageEvent(eSQL))
 	}
 
 	if err := rows.Err(); err != nil {
-		return fmt.Errorf("failed rows.Next: %w", err)
+		return nil, fmt.Errorf("failed rows.Next: %w", err)
+	}
+
+	recurring, err := s.getRecurringEvents(ctx, `id != $1 AND userid = $2 AND ontime < $3`,
+		id, userID, offTime.UTC())
+	if err != nil {
+		return nil, err
+	}
+	for i := range recurring {
+		occurrences, err := recurring[i].Occurrences(onTime, offTime)
+		if err != nil {
+			return nil, fmt.Errorf("failed expand event %v: %w", recurring[i].ID, err)
+		}
+		for _, o := range occurrences {
+			if model.Overlaps(o.OnTime, o.OffTime, onTime, offTime) {
+				overlaps = append(overlaps, o)
+			}
+		}
 	}
 
-	return ErrDateBusy
+	return overlaps, nil
 }
 
 func (s *Storage) GetEventsDayOfNotice(ctx context.Context, date time.Time) ([]model.Event, error) {
 	var e model.Event
-	var events []model.Event
 	var eSQL EventSQL
+	events := []model.Event{}
 
-	query := `SELECT id, userid, title, description, ontime, offtime, notifytime
+	query := `SELECT id, userid, calendarid, title, description, ontime, offtime, notifytime, timezone,
+	          rrule, exdates, overrides, status, version
 	          FROM events
-			  WHERE notified = false AND notifytime <= $1`
+			  WHERE deletedat IS NULL AND rrule IS NULL AND notified = false AND enqueued = false
+			  AND notifytime <= $1`
 
-	rows, err := s.db.QueryContext(ctx, query, date)
+	rows, err := s.db.QueryContext(ctx, query, date.UTC())
 	if err != nil {
 		return events, fmt.Errorf("failed lookup event: %w", err)
 	}
 	defer rows.Close()
 
 	for rows.Next() {
-		if err := rows.Scan(&eSQL.ID, &eSQL.UserID, &eSQL.Title, &eSQL.Description,
-			&eSQL.OnTime, &eSQL.OffTime, &eSQL.NotifyTime); err != nil {
+		if err := rows.Scan(&eSQL.ID, &eSQL.UserID, &eSQL.CalendarID, &eSQL.Title, &eSQL.Description,
+			&eSQL.OnTime, &eSQL.OffTime, &eSQL.NotifyTime, &eSQL.TimeZone,
+			&eSQL.RRule, &eSQL.ExDates, &eSQL.Overrides, &eSQL.Status, &eSQL.Version); err != nil {
 			return events, fmt.Errorf("failed rows.Scan: %w", err)
 		}
 		e = ConvertSQLEventToStorageEvent(eSQL)
@@ -293,13 +528,40 @@ func (s *Storage) GetEventsDayOfNotice(ctx context.Context, date time.Time) ([]m
 		return events, fmt.Errorf("failed lookup event: %w", err)
 	}
 
-	return events, nil
+	recurring, err := s.getRecurringEvents(ctx, `notifytime IS NOT NULL AND notifytime <= $1`, date.UTC())
+	if err != nil {
+		return events, err
+	}
+	for i := range recurring {
+		if occ, ok := recurring[i].DueOccurrence(date); ok {
+			events = append(events, occ)
+		}
+	}
+
+	return events, s.loadAttendees(ctx, events)
 }
 
 func (s *Storage) UpdateEventNotified(ctx context.Context, eventid int64) error {
+	return s.inTx(ctx, func(tx *Storage) error {
+		before, err := tx.eventState(ctx, eventid, false)
+		if err != nil {
+			return err
+		}
+		if err := tx.updateNotified(ctx, *before); err != nil {
+			return err
+		}
+		return tx.recordUpdate(ctx, model.AuditNotified, before)
+	})
+}
+
+func (s *Storage) updateNotified(ctx context.Context, e model.Event) error {
+	if e.IsRecurring() {
+		return s.updateRecurringNotified(ctx, &e)
+	}
+
 	query := `UPDATE events SET notified = true WHERE id = $1`
 
-	res, err := s.db.ExecContext(ctx, query, eventid)
+	res, err := s.db.ExecContext(ctx, query, e.ID)
 	if err != nil {
 		return fmt.Errorf("failed update event: %w", err)
 	}
@@ -309,6 +571,9 @@ func (s *Storage) UpdateEventNotified(ctx context.Context, eventid int64) error
 		return fmt.Errorf("failed get RowsAffected: %w", err)
 	}
 
+	if rowsAffected == 0 {
+		return ErrEventNotFound
+	}
 	if rowsAffected != 1 {
 		return fmt.Errorf("failed rowsAffected: %v", rowsAffected)
 	}
@@ -316,19 +581,515 @@ func (s *Storage) UpdateEventNotified(ctx context.Context, eventid int64) error
 	return nil
 }
 
-func (s *Storage) DeleteEventsOlderDate(ctx context.Context, date time.Time) (int64, error) {
-	query := `DELETE FROM events
-	          WHERE offtime < $1`
+func (s *Storage) updateRecurringNotified(ctx context.Context, e *model.Event) error {
+	e.MarkNotified(time.Now())
+	query := `UPDATE events SET lastnotified = $2 WHERE id = $1`
+
+	if _, err := s.db.ExecContext(ctx, query, e.ID, timeNull(e.LastNotified)); err != nil {
+		return fmt.Errorf("failed update event: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) GetUser(ctx context.Context, userID int64) (model.User, error) {
+	var timeZone, policy, email, locale sql.NullString
+	u := model.User{ID: userID}
+	query := `SELECT timezone, conflictpolicy, email, locale FROM users WHERE id = $1`
+
+	if err := s.db.QueryRowContext(ctx, query, userID).Scan(&timeZone, &policy, &email, &locale); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return u, nil
+		}
+		return u, fmt.Errorf("failed rows.Scan: %w", err)
+	}
+
+	if timeZone.Valid {
+		u.TimeZone = timeZone.String
+	}
+	if policy.Valid {
+		u.ConflictPolicy = model.ConflictPolicy(policy.String)
+	}
+	u.Email = email.String
+	u.Locale = locale.String
+	return u, nil
+}
+
+func (s *Storage) UpsertUser(ctx context.Context, u *model.User) error {
+	query := `INSERT INTO users (id, timezone, conflictpolicy, email, locale) VALUES ($1, $2, $3, $4, $5)
+	          ON CONFLICT (id) DO UPDATE SET timezone = EXCLUDED.timezone, conflictpolicy = EXCLUDED.conflictpolicy,
+	          email = EXCLUDED.email, locale = EXCLUDED.locale`
+
+	_, err := s.db.ExecContext(ctx, query, u.ID, stringNull(u.TimeZone), stringNull(string(u.ConflictPolicy)),
+		stringNull(u.Email), stringNull(u.Locale))
+	if err != nil {
+		return fmt.Errorf("failed to upsert user: %w", err)
+	}
+	return nil
+}
+
+type FeedTokenSQL struct {
+	Token    sql.NullString
+	UserID   sql.NullInt64
+	Created  sql.NullTime
+	ETag     sql.NullString
+	Modified sql.NullTime
+}
+
+func convertSQLFeedToken(t FeedTokenSQL) model.FeedToken {
+	return model.FeedToken{
+		Token:    t.Token.String,
+		UserID:   t.UserID.Int64,
+		Created:  t.Created.Time,
+		ETag:     t.ETag.String,
+		Modified: t.Modified.Time,
+	}
+}
+
+func (s *Storage) InsertFeedToken(ctx context.Context, t *model.FeedToken) error {
+	query := `INSERT INTO feed_tokens (token, userid, created, etag, modified) VALUES ($1, $2, $3, $4, $5)`
+
+	_, err := s.db.ExecContext(ctx, query, t.Token, t.UserID, t.Created.UTC(), stringNull(t.ETag), timeNull(t.Modified))
+	if err != nil {
+		return fmt.Errorf("failed to insert feed token: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) UpdateFeedToken(ctx context.Context, t *model.FeedToken) error {
+	query := `UPDATE feed_tokens SET etag = $2, modified = $3 WHERE token = $1`
 
-	res, err := s.db.ExecContext(ctx, query, date)
+	result, err := s.db.ExecContext(ctx, query, t.Token, stringNull(t.ETag), timeNull(t.Modified))
 	if err != nil {
-		return 0, fmt.Errorf("failed delete event: %w", err)
+		return fmt.Errorf("failed to update feed token: %w", err)
 	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrFeedTokenNotFound
+	}
+	return nil
+}
 
-	rowsAffected, err := res.RowsAffected()
+func (s *Storage) DeleteFeedToken(ctx context.Context, token string) error {
+	query := `DELETE FROM feed_tokens WHERE token = $1`
+
+	result, err := s.db.ExecContext(ctx, query, token)
+	if err != nil {
+		return fmt.Errorf("failed to delete feed token: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrFeedTokenNotFound
+	}
+	return nil
+}
+
+func (s *Storage) GetFeedToken(ctx context.Context, token string) (model.FeedToken, error) {
+	var tSQL FeedTokenSQL
+	query := `SELECT token, userid, created, etag, modified FROM feed_tokens WHERE token = $1`
+
+	if err := s.db.QueryRowxContext(ctx, query, token).StructScan(&tSQL); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.FeedToken{}, ErrFeedTokenNotFound
+		}
+		return model.FeedToken{}, fmt.Errorf("failed to get feed token: %w", err)
+	}
+	return convertSQLFeedToken(tSQL), nil
+}
+
+func (s *Storage) GetFeedTokens(ctx context.Context, userID int64) ([]model.FeedToken, error) {
+	var tSQL FeedTokenSQL
+	tokens := []model.FeedToken{}
+	query := `SELECT token, userid, created, etag, modified FROM feed_tokens WHERE userid = $1 ORDER BY created`
+
+	rows, err := s.db.QueryxContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feed tokens: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := rows.StructScan(&tSQL); err != nil {
+			return nil, fmt.Errorf("failed to rows.StructScan: %w", err)
+		}
+		tokens = append(tokens, convertSQLFeedToken(tSQL))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to rows.Err: %w", err)
+	}
+	return tokens, nil
+}
+
+type CalendarSQL struct {
+	ID             sql.NullInt64
+	OwnerID        sql.NullInt64
+	Name           sql.NullString
+	Description    sql.NullString
+	ConflictPolicy sql.NullString
+	Permission     sql.NullString
+}
+
+func convertSQLCalendar(c CalendarSQL) model.Calendar {
+	return model.Calendar{
+		ID:             c.ID.Int64,
+		OwnerID:        c.OwnerID.Int64,
+		Name:           c.Name.String,
+		Description:    c.Description.String,
+		ConflictPolicy: model.ConflictPolicy(c.ConflictPolicy.String),
+		Permission:     model.Permission(c.Permission.String),
+	}
+}
+
+func (s *Storage) InsertCalendar(ctx context.Context, c *model.Calendar) error {
+	query := `INSERT INTO calendars (ownerid, name, description, conflictpolicy) VALUES ($1, $2, $3, $4) RETURNING id`
+
+	err := s.db.QueryRowContext(ctx, query, c.OwnerID, c.Name, stringNull(c.Description),
+		stringNull(string(c.ConflictPolicy))).Scan(&c.ID)
+	if err != nil {
+		return fmt.Errorf("failed to insert calendar: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) UpdateCalendar(ctx context.Context, c *model.Calendar) error {
+	query := `UPDATE calendars SET name = $2, description = $3, conflictpolicy = $4 WHERE id = $1`
+
+	result, err := s.db.ExecContext(ctx, query, c.ID, c.Name, stringNull(c.Description),
+		stringNull(string(c.ConflictPolicy)))
+	if err != nil {
+		return fmt.Errorf("failed to update calendar: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrCalendarNotFound
+	}
+	return nil
+}
+
+// DeleteCalendar deletes a calendar with its events, trashed ones included, the shares are deleted
+// by the foreign keys. The events are deleted one by one in the same transaction, so each deletion is audited.
+func (s *Storage) DeleteCalendar(ctx context.Context, id int64) error {
+	return s.inTx(ctx, func(tx *Storage) error {
+		// the lock holds back the events inserted into the calendar until it is deleted
+		query := `SELECT id FROM calendars WHERE id = $1`
+		if tx.driver != driverSQLite {
+			query += ` FOR UPDATE`
+		}
+		var calendarID int64
+		if err := tx.db.QueryRowContext(ctx, query, id).Scan(&calendarID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrCalendarNotFound
+			}
+			return fmt.Errorf("failed to get calendar: %w", err)
+		}
+
+		var events []struct {
+			ID      int64 `db:"id"`
+			Trashed bool  `db:"trashed"`
+		}
+		query = `SELECT id, deletedat IS NOT NULL AS trashed FROM events WHERE calendarid = $1`
+		if err := tx.db.SelectContext(ctx, &events, query, id); err != nil {
+			return fmt.Errorf("failed to get calendar events: %w", err)
+		}
+		for _, e := range events {
+			if err := tx.removeEvent(ctx, e.ID, e.Trashed); err != nil {
+				return err
+			}
+		}
+
+		if _, err := tx.db.ExecContext(ctx, `DELETE FROM calendars WHERE id = $1`, id); err != nil {
+			return fmt.Errorf("failed to delete calendar: %w", err)
+		}
+		return nil
+	})
+}
+
+func (s *Storage) GetCalendar(ctx context.Context, id int64) (model.Calendar, error) {
+	var cSQL CalendarSQL
+	query := `SELECT id, ownerid, name, description, conflictpolicy, NULL AS permission
+	          FROM calendars WHERE id = $1`
+
+	if err := s.db.QueryRowxContext(ctx, query, id).StructScan(&cSQL); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Calendar{}, ErrCalendarNotFound
+		}
+		return model.Calendar{}, fmt.Errorf("failed to get calendar: %w", err)
+	}
+	return convertSQLCalendar(cSQL), nil
+}
+
+// GetCalendars returns the calendars owned by a user and the calendars shared with them,
+// the permission of each is the access of the user.
+func (s *Storage) GetCalendars(ctx context.Context, userID int64) ([]model.Calendar, error) {
+	var cSQL CalendarSQL
+	calendars := []model.Calendar{}
+	query := `SELECT c.id, c.ownerid, c.name, c.description, c.conflictpolicy,
+	          CASE WHEN c.ownerid = $1 THEN 'owner' ELSE cs.permission END AS permission
+	          FROM calendars c
+	          LEFT JOIN calendar_shares cs ON cs.calendarid = c.id AND cs.userid = $1
+	          WHERE c.ownerid = $1 OR cs.userid = $1
+	          ORDER BY c.id`
+
+	rows, err := s.db.QueryxContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get calendars: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := rows.StructScan(&cSQL); err != nil {
+			return nil, fmt.Errorf("failed to rows.StructScan: %w", err)
+		}
+		calendars = append(calendars, convertSQLCalendar(cSQL))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to rows.Err: %w", err)
+	}
+	return calendars, nil
+}
+
+func (s *Storage) UpsertCalendarShare(ctx context.Context, cs *model.CalendarShare) error {
+	query := `INSERT INTO calendar_shares (calendarid, userid, permission)
+	          SELECT id, $2, $3 FROM calendars WHERE id = $1
+	          ON CONFLICT (calendarid, userid) DO UPDATE SET permission = EXCLUDED.permission`
+
+	result, err := s.db.ExecContext(ctx, query, cs.CalendarID, cs.UserID, string(cs.Permission))
+	if err != nil {
+		return fmt.Errorf("failed to upsert calendar share: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrCalendarNotFound
+	}
+	return nil
+}
+
+func (s *Storage) DeleteCalendarShare(ctx context.Context, calendarID, userID int64) error {
+	query := `DELETE FROM calendar_shares WHERE calendarid = $1 AND userid = $2`
+
+	result, err := s.db.ExecContext(ctx, query, calendarID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete calendar share: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrShareNotFound
+	}
+	return nil
+}
+
+func (s *Storage) GetCalendarShare(ctx context.Context, calendarID, userID int64) (model.CalendarShare, error) {
+	var permission string
+	cs := model.CalendarShare{CalendarID: calendarID, UserID: userID}
+	query := `SELECT permission FROM calendar_shares WHERE calendarid = $1 AND userid = $2`
+
+	if err := s.db.QueryRowContext(ctx, query, calendarID, userID).Scan(&permission); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return cs, ErrShareNotFound
+		}
+		return cs, fmt.Errorf("failed to get calendar share: %w", err)
+	}
+	cs.Permission = model.Permission(permission)
+	return cs, nil
+}
+
+func (s *Storage) GetCalendarShares(ctx context.Context, calendarID int64) ([]model.CalendarShare, error) {
+	shares := []model.CalendarShare{}
+	query := `SELECT userid, permission FROM calendar_shares WHERE calendarid = $1 ORDER BY userid`
+
+	rows, err := s.db.QueryContext(ctx, query, calendarID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get calendar shares: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var permission string
+		cs := model.CalendarShare{CalendarID: calendarID}
+		if err := rows.Scan(&cs.UserID, &permission); err != nil {
+			return nil, fmt.Errorf("failed to rows.Scan: %w", err)
+		}
+		cs.Permission = model.Permission(permission)
+		shares = append(shares, cs)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to rows.Err: %w", err)
+	}
+	return shares, nil
+}
+
+// loadAttendees fills the attendees of events, occurrences of a series share the attendees of the series.
+func (s *Storage) loadAttendees(ctx context.Context, events []model.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(events))
+	for i := range events {
+		ids = append(ids, events[i].ID)
+	}
+	query, args, err := sqlx.In(`SELECT eventid, userid, status FROM attendees WHERE eventid IN (?)
+	          ORDER BY eventid, userid`, ids)
+	if err != nil {
+		return fmt.Errorf("failed to build attendees query: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, s.db.Rebind(query), args...)
+	if err != nil {
+		return fmt.Errorf("failed to get attendees: %w", err)
+	}
+	defer rows.Close()
+
+	attendees := make(map[int64][]model.Attendee)
+	for rows.Next() {
+		var eventID int64
+		var a model.Attendee
+		if err := rows.Scan(&eventID, &a.UserID, &a.Status); err != nil {
+			return fmt.Errorf("failed to rows.Scan: %w", err)
+		}
+		attendees[eventID] = append(attendees[eventID], a)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to rows.Err: %w", err)
+	}
+
+	for i := range events {
+		events[i].Attendees = attendees[events[i].ID]
+	}
+	return nil
+}
+
+// SetAttendee adds an attendee to an event or changes its status.
+func (s *Storage) SetAttendee(ctx context.Context, eventID int64, a model.Attendee) error {
+	return s.inTx(ctx, func(tx *Storage) error {
+		before, err := tx.eventState(ctx, eventID, false)
+		if err != nil {
+			return err
+		}
+
+		query := `INSERT INTO attendees (eventid, userid, status)
+		          SELECT id, $2, $3 FROM events WHERE id = $1 AND deletedat IS NULL
+		          ON CONFLICT (eventid, userid) DO UPDATE SET status = EXCLUDED.status`
+
+		result, err := tx.db.ExecContext(ctx, query, eventID, a.UserID, string(a.Status))
+		if err != nil {
+			return fmt.Errorf("failed to set attendee: %w", err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rowsAffected == 0 {
+			return ErrEventNotFound
+		}
+		if err := tx.incrementVersion(ctx, eventID); err != nil {
+			return err
+		}
+		return tx.recordUpdate(ctx, model.AuditUpdate, before)
+	})
+}
+
+func (s *Storage) DeleteAttendee(ctx context.Context, eventID, userID int64) error {
+	return s.inTx(ctx, func(tx *Storage) error {
+		before, err := tx.eventState(ctx, eventID, false)
+		if err != nil {
+			return err
+		}
+
+		query := `DELETE FROM attendees WHERE eventid = $1 AND userid = $2`
+
+		result, err := tx.db.ExecContext(ctx, query, eventID, userID)
+		if err != nil {
+			return fmt.Errorf("failed to delete attendee: %w", err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rowsAffected == 0 {
+			return tx.missingEventOr(ctx, eventID, ErrAttendeeNotFound)
+		}
+		if err := tx.incrementVersion(ctx, eventID); err != nil {
+			return err
+		}
+		return tx.recordUpdate(ctx, model.AuditUpdate, before)
+	})
+}
+
+// missingEventOr returns ErrEventNotFound when the event is missing and err otherwise.
+func (s *Storage) missingEventOr(ctx context.Context, eventID int64, err error) error {
+	var id int64
+	if err := s.db.QueryRowContext(ctx, `SELECT id FROM events WHERE id = $1 AND deletedat IS NULL`,
+		eventID).Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrEventNotFound
+		}
+		return fmt.Errorf("failed to get event: %w", err)
+	}
+	return err
+}
+
+// incrementVersion marks a change of the attendees of an event.
+func (s *Storage) incrementVersion(ctx context.Context, eventID int64) error {
+	if _, err := s.db.ExecContext(ctx, `UPDATE events SET version = version + 1 WHERE id = $1`, eventID); err != nil {
+		return fmt.Errorf("failed to update event version: %w", err)
+	}
+	return nil
+}
+
+// GetInvitations returns the events a user is invited to with the status of the user.
+func (s *Storage) GetInvitations(ctx context.Context, userID int64) ([]model.Invitation, error) {
+	var eSQL EventSQL
+	var events []model.Event
+	invitations := []model.Invitation{}
+
+	query := `SELECT e.id, e.userid, e.calendarid, e.title, e.description, e.ontime, e.offtime, e.notifytime,
+	          e.timezone, e.rrule, e.exdates, e.overrides, e.status, e.version
+	          FROM events e
+	          JOIN attendees a ON a.eventid = e.id
+	          WHERE a.userid = $1 AND e.deletedat IS NULL
+	          ORDER BY e.ontime`
+
+	rows, err := s.db.QueryContext(ctx, query, userID)
 	if err != nil {
-		return 0, fmt.Errorf("failed get RowsAffected: %w", err)
+		return nil, fmt.Errorf("failed to get invitations: %w", err)
 	}
+	defer rows.Close()
 
-	return rowsAffected, nil
+	for rows.Next() {
+		if err := rows.Scan(&eSQL.ID, &eSQL.UserID, &eSQL.CalendarID, &eSQL.Title, &eSQL.Description,
+			&eSQL.OnTime, &eSQL.OffTime, &eSQL.NotifyTime, &eSQL.TimeZone,
+			&eSQL.RRule, &eSQL.ExDates, &eSQL.Overrides, &eSQL.Status, &eSQL.Version); err != nil {
+			return nil, fmt.Errorf("failed rows.Scan: %w", err)
+		}
+		events = append(events, ConvertSQLEventToStorageEvent(eSQL))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to rows.Err: %w", err)
+	}
+
+	if err := s.loadAttendees(ctx, events); err != nil {
+		return nil, err
+	}
+	for _, e := range events {
+		a, _ := e.Attendee(userID)
+		invitations = append(invitations, model.Invitation{Event: e, Status: a.Status})
+	}
+	return invitations, nil
 }
@@ -0,0 +1,107 @@
+package sqlstorage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+)
+
+// GetTrash returns the trashed events of a user, the latest deleted first.
+func (s *Storage) GetTrash(ctx context.Context, userID int64) ([]model.Event, error) {
+	var eSQL EventSQL
+	events := []model.Event{}
+
+	query := `SELECT id, userid, calendarid, title, description, ontime, offtime, notifytime, timezone,
+	          rrule, exdates, overrides, status, version, deletedat
+	          FROM events
+	          WHERE userid = $1 AND deletedat IS NOT NULL
+	          ORDER BY deletedat DESC, id`
+
+	rows, err := s.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trash: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := rows.Scan(&eSQL.ID, &eSQL.UserID, &eSQL.CalendarID, &eSQL.Title, &eSQL.Description,
+			&eSQL.OnTime, &eSQL.OffTime, &eSQL.NotifyTime, &eSQL.TimeZone,
+			&eSQL.RRule, &eSQL.ExDates, &eSQL.Overrides, &eSQL.Status, &eSQL.Version, &eSQL.DeletedAt); err != nil {
+			return nil, fmt.Errorf("failed rows.Scan: %w", err)
+		}
+		events = append(events, ConvertSQLEventToStorageEvent(eSQL))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to rows.Err: %w", err)
+	}
+
+	return events, s.loadAttendees(ctx, events)
+}
+
+// RestoreEvent moves an event back from the trash.
+func (s *Storage) RestoreEvent(ctx context.Context, id int64) error {
+	return s.inTx(ctx, func(tx *Storage) error {
+		before, err := tx.eventState(ctx, id, true)
+		if err != nil {
+			return err
+		}
+
+		query := `UPDATE events SET deletedat = NULL WHERE id = $1 AND deletedat IS NOT NULL`
+		result, err := tx.db.ExecContext(ctx, query, id)
+		if err != nil {
+			return fmt.Errorf("failed to restore event: %w", err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rowsAffected == 0 {
+			return ErrEventNotFound
+		}
+		return tx.recordUpdate(ctx, model.AuditRestore, before)
+	})
+}
+
+// PurgeTrash removes for good the events trashed before date in one transaction.
+func (s *Storage) PurgeTrash(ctx context.Context, date time.Time) (int64, error) {
+	var purged int64
+	err := s.inTx(ctx, func(tx *Storage) error {
+		var ids []int64
+		query := `SELECT id FROM events WHERE deletedat < $1`
+		if err := tx.db.SelectContext(ctx, &ids, query, date.UTC()); err != nil {
+			return fmt.Errorf("failed lookup trashed events: %w", err)
+		}
+		for _, id := range ids {
+			if err := tx.removeEvent(ctx, id, true); err != nil {
+				return err
+			}
+		}
+		purged = int64(len(ids))
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return purged, nil
+}
+
+// removeEvent deletes a stored or trashed event for good, the attendees are deleted by the foreign keys.
+func (s *Storage) removeEvent(ctx context.Context, id int64, trashed bool) error {
+	return s.inTx(ctx, func(tx *Storage) error {
+		before, err := tx.eventState(ctx, id, trashed)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.db.ExecContext(ctx, `DELETE FROM events WHERE id = $1`, id); err != nil {
+			return fmt.Errorf("failed to delete event: %w", err)
+		}
+		action := model.AuditDelete
+		if trashed {
+			action = model.AuditPurge
+		}
+		return tx.record(ctx, action, id, before, nil)
+	})
+}
@@ -0,0 +1,37 @@
+package sqlstorage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// dbtx runs the queries of a Storage on the database, or in a transaction for the Storage given by inTx.
+type dbtx interface {
+	sqlx.ExtContext
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+}
+
+// inTx runs fn with a Storage whose queries are in one transaction, committed when fn succeeds.
+// Inside a transaction fn joins it.
+func (s *Storage) inTx(ctx context.Context, fn func(tx *Storage) error) error {
+	if _, ok := s.db.(*sqlx.Tx); ok {
+		return fn(s)
+	}
+	tx, err := s.pool.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	if err := fn(&Storage{driver: s.driver, dsn: s.dsn, pool: s.pool, db: tx}); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
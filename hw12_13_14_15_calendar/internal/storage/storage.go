@@ -2,18 +2,23 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"time"
 
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
 	memorystorage "github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/storage/memory"
 	sqlstorage "github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/storage/sql"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/storage/storageerr"
 )
 
 type Conf struct {
 	DB  string `toml:"db"`
 	DSN string `toml:"dsn"`
+	// Migrate applies the pending migrations on start instead of refusing an outdated schema.
+	Migrate bool `toml:"migrate"`
 }
 
 type Storage interface {
@@ -25,14 +30,90 @@ type Storage interface {
 	GetEventByID(context.Context, int64) (model.Event, error)
 	GetAllEvents(context.Context, int64) ([]model.Event, error)
 	GetAllRange(context.Context, int64, time.Time, time.Time) ([]model.Event, error)
-	IsBusyDateTimeRange(context.Context, int64, int64, time.Time, time.Time) error
+	GetOverlaps(context.Context, int64, int64, time.Time, time.Time) ([]model.Event, error)
+	GetUser(context.Context, int64) (model.User, error)
+	UpsertUser(context.Context, *model.User) error
+	InsertFeedToken(context.Context, *model.FeedToken) error
+	UpdateFeedToken(context.Context, *model.FeedToken) error
+	DeleteFeedToken(context.Context, string) error
+	GetFeedToken(context.Context, string) (model.FeedToken, error)
+	GetFeedTokens(context.Context, int64) ([]model.FeedToken, error)
+	InsertCalendar(context.Context, *model.Calendar) error
+	UpdateCalendar(context.Context, *model.Calendar) error
+	DeleteCalendar(context.Context, int64) error
+	GetCalendar(context.Context, int64) (model.Calendar, error)
+	GetCalendars(context.Context, int64) ([]model.Calendar, error)
+	GetCalendarRange(context.Context, int64, time.Time, time.Time) ([]model.Event, error)
+	UpsertCalendarShare(context.Context, *model.CalendarShare) error
+	DeleteCalendarShare(context.Context, int64, int64) error
+	GetCalendarShare(context.Context, int64, int64) (model.CalendarShare, error)
+	GetCalendarShares(context.Context, int64) ([]model.CalendarShare, error)
+	SetAttendee(context.Context, int64, model.Attendee) error
+	DeleteAttendee(context.Context, int64, int64) error
+	GetInvitations(context.Context, int64) ([]model.Invitation, error)
+	GetEventHistory(context.Context, int64) ([]model.AuditEntry, error)
+	GetTrash(context.Context, int64) ([]model.Event, error)
+	RestoreEvent(context.Context, int64) error
 
 	// for producers
 	GetEventsDayOfNotice(context.Context, time.Time) ([]model.Event, error)
 	DeleteEventsOlderDate(context.Context, time.Time) (int64, error)
+	GetEventsOlderDate(context.Context, time.Time, int) ([]model.Event, error)
+	DeleteEvents(context.Context, []int64) (int64, error)
+	PurgeTrash(context.Context, time.Time) (int64, error)
+	EnqueueNotifications(context.Context, time.Time) (int64, error)
+	GetOutbox(context.Context, int) ([]model.OutboxEntry, error)
+	MarkOutboxSent(context.Context, int64) error
+	MarkOutboxFailed(context.Context, int64, string) error
+	PurgeOutbox(context.Context, time.Time) (int64, error)
 
 	// for consumers
 	UpdateEventNotified(context.Context, int64) error
+	IsNotificationDelivered(context.Context, string) (bool, error)
+	MarkNotificationDelivered(context.Context, string) error
+}
+
+// Migrator is a storage with a versioned schema, the migrations are embedded in the binary.
+type Migrator interface {
+	// Migrate runs "up", "down" or "status" and writes the outcome to w.
+	Migrate(ctx context.Context, command string, w io.Writer) error
+	// CheckSchema returns an error when the schema is older than the migrations.
+	CheckSchema(ctx context.Context) error
+}
+
+// The errors of every storage, Storage implementations return them for missing rows.
+var (
+	ErrEventNotFound     = storageerr.ErrEventNotFound
+	ErrFeedTokenNotFound = storageerr.ErrFeedTokenNotFound
+	ErrCalendarNotFound  = storageerr.ErrCalendarNotFound
+	ErrShareNotFound     = storageerr.ErrShareNotFound
+	ErrAttendeeNotFound  = storageerr.ErrAttendeeNotFound
+	ErrVersionConflict   = storageerr.ErrVersionConflict
+)
+
+// IsEventNotFound reports whether err is the missing event error of any storage.
+func IsEventNotFound(err error) bool {
+	return errors.Is(err, ErrEventNotFound)
+}
+
+// IsCalendarNotFound reports whether err is the missing calendar error of any storage.
+func IsCalendarNotFound(err error) bool {
+	return errors.Is(err, ErrCalendarNotFound)
+}
+
+// IsShareNotFound reports whether err is the missing calendar share error of any storage.
+func IsShareNotFound(err error) bool {
+	return errors.Is(err, ErrShareNotFound)
+}
+
+// IsVersionConflict reports whether err is the stale update error of any storage.
+func IsVersionConflict(err error) bool {
+	return errors.Is(err, ErrVersionConflict)
+}
+
+// IsAttendeeNotFound reports whether err is the missing attendee error of any storage.
+func IsAttendeeNotFound(err error) bool {
+	return errors.Is(err, ErrAttendeeNotFound)
 }
 
 func NewStorage(conf Conf) Storage {
@@ -41,6 +122,8 @@ func NewStorage(conf Conf) Storage {
 		return memorystorage.New()
 	case "sql":
 		return sqlstorage.New(conf.DSN)
+	case "sqlite":
+		return sqlstorage.NewSQLite(conf.DSN)
 	}
 
 	fmt.Fprintln(os.Stderr, "wrong DB")
@@ -0,0 +1,14 @@
+// Package storageerr holds the errors shared by all storages, so callers match them with errors.Is
+// whatever storage is configured.
+package storageerr
+
+import "errors"
+
+var (
+	ErrEventNotFound     = errors.New("event not found")
+	ErrFeedTokenNotFound = errors.New("feed token not found")
+	ErrCalendarNotFound  = errors.New("calendar not found")
+	ErrShareNotFound     = errors.New("calendar share not found")
+	ErrAttendeeNotFound  = errors.New("attendee not found")
+	ErrVersionConflict   = errors.New("event was changed since the version given")
+)
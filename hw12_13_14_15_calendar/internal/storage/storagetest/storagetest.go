@@ -0,0 +1,786 @@
+// Package storagetest is the conformance suite of storage.Storage. Every storage runs it from its own tests
+// with Run, so the storages keep behaving the same way.
+package storagetest
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/storage"
+	"github.com/stretchr/testify/require"
+)
+
+// missingID is an ID no test stores.
+const missingID = int64(1) << 40
+
+// base is the start of the events of the suite, a Monday.
+var base = time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+// Run runs the suite, newStorage returns an empty connected storage for each test.
+func Run(t *testing.T, newStorage func(t *testing.T) storage.Storage) {
+	t.Helper()
+	tests := []struct {
+		name string
+		test func(t *testing.T, s storage.Storage)
+	}{
+		{"events", testEvents},
+		{"versions", testVersions},
+		{"missing events", testMissingEvents},
+		{"all events", testAllEvents},
+		{"range boundaries", testRange},
+		{"recurring range", testRecurringRange},
+		{"overlaps", testOverlaps},
+		{"notices", testNotices},
+		{"recurring notices", testRecurringNotices},
+		{"outbox", testOutbox},
+		{"retention", testRetention},
+		{"users", testUsers},
+		{"feed tokens", testFeedTokens},
+		{"calendars", testCalendars},
+		{"attendees", testAttendees},
+		{"history", testHistory},
+		{"trash", testTrash},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.test(t, newStorage(t))
+		})
+	}
+}
+
+func insert(t *testing.T, s storage.Storage, e model.Event) model.Event {
+	t.Helper()
+	require.NoError(t, s.InsertEvent(context.Background(), &e))
+	require.NotZero(t, e.ID)
+	return e
+}
+
+// event returns an event of a user starting hours after base and lasting an hour.
+func event(userID int64, title string, hours int) model.Event {
+	onTime := base.Add(time.Duration(hours) * time.Hour)
+	return model.Event{UserID: userID, Title: title, OnTime: onTime, OffTime: onTime.Add(time.Hour)}
+}
+
+func ids(events []model.Event) []int64 {
+	result := make([]int64, 0, len(events))
+	for _, e := range events {
+		result = append(result, e.ID)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
+
+func requireSameEvent(t *testing.T, want, got model.Event) {
+	t.Helper()
+	require.Equal(t, want.ID, got.ID)
+	require.Equal(t, want.UserID, got.UserID)
+	require.Equal(t, want.CalendarID, got.CalendarID)
+	require.Equal(t, want.Title, got.Title)
+	require.Equal(t, want.Description, got.Description)
+	require.True(t, want.OnTime.Equal(got.OnTime), "ontime %v, want %v", got.OnTime, want.OnTime)
+	require.True(t, want.OffTime.Equal(got.OffTime), "offtime %v, want %v", got.OffTime, want.OffTime)
+	require.True(t, want.NotifyTime.Equal(got.NotifyTime), "notifytime %v, want %v", got.NotifyTime, want.NotifyTime)
+	require.Equal(t, want.TimeZone, got.TimeZone)
+	require.Equal(t, want.RRule, got.RRule)
+	require.Equal(t, want.Status, got.Status)
+}
+
+func testEvents(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	e := event(1, "review", 0)
+	e.Description = "quarterly"
+	e.NotifyTime = e.OnTime.Add(-15 * time.Minute)
+	e.TimeZone = "Europe/Moscow"
+	e.Status = model.EventStatusTentative
+	e = insert(t, s, e)
+
+	stored, err := s.GetEventByID(ctx, e.ID)
+	require.NoError(t, err)
+	requireSameEvent(t, e, stored)
+
+	e.Title = "planning"
+	e.Status = model.EventStatusConfirmed
+	e.OffTime = e.OffTime.Add(time.Hour)
+	require.NoError(t, s.UpdateEvent(ctx, &e))
+	stored, err = s.GetEventByID(ctx, e.ID)
+	require.NoError(t, err)
+	requireSameEvent(t, e, stored)
+
+	require.NoError(t, s.DeleteEvent(ctx, e.ID))
+	_, err = s.GetEventByID(ctx, e.ID)
+	require.ErrorIs(t, err, storage.ErrEventNotFound)
+}
+
+// testVersions checks that every change of an event increments its version and stale updates are rejected.
+func testVersions(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	e := insert(t, s, event(1, "review", 0))
+	require.Equal(t, int64(1), e.Version)
+	stale := e
+
+	e.Title = "first"
+	require.NoError(t, s.UpdateEvent(ctx, &e))
+	require.Equal(t, int64(2), e.Version)
+
+	stale.Title = "stale"
+	require.ErrorIs(t, s.UpdateEvent(ctx, &stale), storage.ErrVersionConflict)
+	stored, err := s.GetEventByID(ctx, e.ID)
+	require.NoError(t, err)
+	require.Equal(t, "first", stored.Title)
+	require.Equal(t, int64(2), stored.Version)
+
+	// zero updates any version
+	stale.Version = 0
+	require.NoError(t, s.UpdateEvent(ctx, &stale))
+	require.Equal(t, int64(3), stale.Version)
+
+	require.NoError(t, s.SetAttendee(ctx, e.ID, model.Attendee{UserID: 2, Status: model.PartStatNeedsAction}))
+	stored, err = s.GetEventByID(ctx, e.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), stored.Version)
+	require.NoError(t, s.DeleteAttendee(ctx, e.ID, 2))
+	stored, err = s.GetEventByID(ctx, e.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), stored.Version)
+
+	// notifications are not changes of the event
+	require.NoError(t, s.UpdateEventNotified(ctx, e.ID))
+	stored, err = s.GetEventByID(ctx, e.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), stored.Version)
+
+	missing := event(1, "missing", 0)
+	missing.ID, missing.Version = missingID, 1
+	require.ErrorIs(t, s.UpdateEvent(ctx, &missing), storage.ErrEventNotFound)
+}
+
+func testMissingEvents(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	_, err := s.GetEventByID(ctx, missingID)
+	require.ErrorIs(t, err, storage.ErrEventNotFound)
+
+	e := event(1, "missing", 0)
+	e.ID = missingID
+	require.ErrorIs(t, s.UpdateEvent(ctx, &e), storage.ErrEventNotFound)
+	require.ErrorIs(t, s.DeleteEvent(ctx, missingID), storage.ErrEventNotFound)
+	require.ErrorIs(t, s.UpdateEventNotified(ctx, missingID), storage.ErrEventNotFound)
+}
+
+func testAllEvents(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	events, err := s.GetAllEvents(ctx, 1)
+	require.NoError(t, err)
+	require.NotNil(t, events)
+	require.Empty(t, events)
+
+	first := insert(t, s, event(1, "first", 0))
+	second := insert(t, s, event(1, "second", 48))
+	insert(t, s, event(2, "other user", 0))
+
+	events, err = s.GetAllEvents(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, ids([]model.Event{first, second}), ids(events))
+}
+
+// testRange checks that ranges are half-open like the overlaps: an event touching a range with an endpoint
+// is not in it.
+func testRange(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	e := insert(t, s, event(1, "meeting", 0))
+	insert(t, s, event(2, "other user", 0))
+
+	tests := []struct {
+		name       string
+		begin, end time.Time
+		want       int
+	}{
+		{"inside", e.OnTime.Add(10 * time.Minute), e.OnTime.Add(20 * time.Minute), 1},
+		{"containing", e.OnTime.Add(-time.Hour), e.OffTime.Add(time.Hour), 1},
+		{"ending at start", e.OnTime.Add(-time.Hour), e.OnTime, 0},
+		{"starting at end", e.OffTime, e.OffTime.Add(time.Hour), 0},
+		{"before", e.OnTime.Add(-time.Hour), e.OnTime.Add(-time.Second), 0},
+		{"after", e.OffTime.Add(time.Second), e.OffTime.Add(time.Hour), 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			events, err := s.GetAllRange(ctx, 1, tc.begin, tc.end)
+			require.NoError(t, err)
+			require.NotNil(t, events)
+			require.Len(t, events, tc.want)
+			if tc.want > 0 {
+				requireSameEvent(t, e, events[0])
+			}
+		})
+	}
+}
+
+func testRecurringRange(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	e := event(1, "stand-up", 0)
+	e.RRule = "FREQ=DAILY;COUNT=5"
+	e.ExDates = []time.Time{base.AddDate(0, 0, 1)}
+	insert(t, s, e)
+
+	events, err := s.GetAllRange(ctx, 1, base, base.AddDate(0, 0, 7))
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	for _, o := range events {
+		require.NotEqual(t, base.AddDate(0, 0, 1), o.OnTime.UTC(), "excluded date returned")
+	}
+
+	events, err = s.GetAllRange(ctx, 1, base.AddDate(0, 0, 2), base.AddDate(0, 0, 2).Add(time.Minute))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.True(t, base.AddDate(0, 0, 2).Equal(events[0].OnTime))
+}
+
+// testOverlaps checks that overlaps are half-open: back-to-back events don't overlap.
+func testOverlaps(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	e := insert(t, s, event(1, "meeting", 0))
+	insert(t, s, event(2, "other user", 0))
+	series := event(1, "series", 24)
+	series.RRule = "FREQ=DAILY;COUNT=3"
+	series = insert(t, s, series)
+
+	tests := []struct {
+		name       string
+		begin, end time.Time
+		want       []int64
+	}{
+		{"inside", e.OnTime.Add(10 * time.Minute), e.OnTime.Add(20 * time.Minute), []int64{e.ID}},
+		{"containing", e.OnTime.Add(-time.Hour), e.OffTime.Add(time.Hour), []int64{e.ID}},
+		{"crossing start", e.OnTime.Add(-30 * time.Minute), e.OnTime.Add(30 * time.Minute), []int64{e.ID}},
+		{"back to back before", e.OnTime.Add(-time.Hour), e.OnTime, []int64{}},
+		{"back to back after", e.OffTime, e.OffTime.Add(time.Hour), []int64{}},
+		{"occurrence", base.AddDate(0, 0, 2), base.AddDate(0, 0, 2).Add(time.Hour), []int64{series.ID}},
+		{"between occurrences", base.AddDate(0, 0, 2).Add(time.Hour), base.AddDate(0, 0, 3), []int64{}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			overlaps, err := s.GetOverlaps(ctx, 0, 1, tc.begin, tc.end)
+			require.NoError(t, err)
+			require.NotNil(t, overlaps)
+			require.Equal(t, tc.want, ids(overlaps))
+		})
+	}
+
+	// an event doesn't overlap itself
+	overlaps, err := s.GetOverlaps(ctx, e.ID, 1, e.OnTime, e.OffTime)
+	require.NoError(t, err)
+	require.Empty(t, overlaps)
+}
+
+func testNotices(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	e := event(1, "notified", 0)
+	e.NotifyTime = e.OnTime.Add(-time.Hour)
+	e = insert(t, s, e)
+	later := event(1, "later", 24)
+	later.NotifyTime = later.OnTime.Add(-time.Hour)
+	insert(t, s, later)
+	insert(t, s, event(1, "without notice", 0))
+
+	notices, err := s.GetEventsDayOfNotice(ctx, e.NotifyTime.Add(-time.Second))
+	require.NoError(t, err)
+	require.NotNil(t, notices)
+	require.Empty(t, notices)
+
+	notices, err = s.GetEventsDayOfNotice(ctx, e.NotifyTime)
+	require.NoError(t, err)
+	require.Equal(t, []int64{e.ID}, ids(notices))
+
+	require.NoError(t, s.UpdateEventNotified(ctx, e.ID))
+	notices, err = s.GetEventsDayOfNotice(ctx, e.OffTime)
+	require.NoError(t, err)
+	require.Empty(t, notices)
+
+	// an update keeps the event notified
+	e.Title = "renamed"
+	require.NoError(t, s.UpdateEvent(ctx, &e))
+	enqueued, err := s.EnqueueNotifications(ctx, e.OffTime)
+	require.NoError(t, err)
+	require.Zero(t, enqueued)
+}
+
+func testRecurringNotices(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	e := event(1, "stand-up", 0)
+	e.NotifyTime = e.OnTime.Add(-15 * time.Minute)
+	e.RRule = "FREQ=DAILY;COUNT=3"
+	e = insert(t, s, e)
+
+	date := base.AddDate(0, 0, 1).Add(-10 * time.Minute)
+	notices, err := s.GetEventsDayOfNotice(ctx, date)
+	require.NoError(t, err)
+	require.Len(t, notices, 1)
+	require.Equal(t, e.ID, notices[0].ID)
+	require.True(t, base.AddDate(0, 0, 1).Equal(notices[0].OnTime))
+
+	// the series is in the past, marking it notified now covers all of its occurrences
+	require.NoError(t, s.UpdateEventNotified(ctx, e.ID))
+	notices, err = s.GetEventsDayOfNotice(ctx, date.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Empty(t, notices)
+}
+
+// testOutbox checks that every due occurrence is written to the outbox once under its own key.
+func testOutbox(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	e := event(1, "single", 0)
+	e.NotifyTime = e.OnTime.Add(-time.Hour)
+	e = insert(t, s, e)
+	require.NoError(t, s.SetAttendee(ctx, e.ID, model.Attendee{UserID: 2, Status: model.PartStatAccepted}))
+	series := event(1, "stand-up", 0)
+	series.NotifyTime = series.OnTime.Add(-15 * time.Minute)
+	series.RRule = "FREQ=DAILY;COUNT=3"
+	series = insert(t, s, series)
+
+	date := base.Add(-10 * time.Minute)
+	enqueued, err := s.EnqueueNotifications(ctx, date)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), enqueued)
+	notices, err := s.GetEventsDayOfNotice(ctx, date)
+	require.NoError(t, err)
+	require.Empty(t, notices)
+	enqueued, err = s.EnqueueNotifications(ctx, date)
+	require.NoError(t, err)
+	require.Zero(t, enqueued)
+
+	// an update keeps the occurrences enqueued
+	e.Title, e.Version = "renamed", 0
+	require.NoError(t, s.UpdateEvent(ctx, &e))
+	series.Title = "renamed stand-up"
+	require.NoError(t, s.UpdateEvent(ctx, &series))
+	enqueued, err = s.EnqueueNotifications(ctx, date)
+	require.NoError(t, err)
+	require.Zero(t, enqueued)
+
+	// the next occurrence of the series is due under a new key
+	enqueued, err = s.EnqueueNotifications(ctx, date.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), enqueued)
+
+	entries, err := s.GetOutbox(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+	keys := map[string]bool{}
+	for _, entry := range entries {
+		require.NotEmpty(t, entry.Msg.Key)
+		keys[entry.Msg.Key] = true
+	}
+	require.Len(t, keys, 4)
+	require.Equal(t, e.ID, entries[0].Msg.ID)
+	require.Equal(t, "single", entries[0].Msg.Title)
+	require.True(t, e.OnTime.Equal(entries[0].Msg.Date))
+	require.Equal(t, series.ID, entries[3].Msg.ID)
+	require.True(t, base.AddDate(0, 0, 1).Equal(entries[3].Msg.Date))
+
+	require.NoError(t, s.MarkOutboxFailed(ctx, entries[0].ID, "down"))
+	limited, err := s.GetOutbox(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, limited, 1)
+	require.Equal(t, 1, limited[0].Attempts)
+	require.Equal(t, "down", limited[0].Error)
+
+	require.NoError(t, s.MarkOutboxSent(ctx, entries[0].ID))
+	pending, err := s.GetOutbox(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, pending, 3)
+	require.Equal(t, entries[1].ID, pending[0].ID)
+
+	key := entries[0].Msg.Key
+	delivered, err := s.IsNotificationDelivered(ctx, key)
+	require.NoError(t, err)
+	require.False(t, delivered)
+	require.NoError(t, s.MarkNotificationDelivered(ctx, key))
+	delivered, err = s.IsNotificationDelivered(ctx, key)
+	require.NoError(t, err)
+	require.True(t, delivered)
+	delivered, err = s.IsNotificationDelivered(ctx, "missing")
+	require.NoError(t, err)
+	require.False(t, delivered)
+	// a key without an outbox entry, as the delivery through one channel, is kept as well
+	require.NoError(t, s.MarkNotificationDelivered(ctx, key+"/smtp"))
+	delivered, err = s.IsNotificationDelivered(ctx, key+"/smtp")
+	require.NoError(t, err)
+	require.True(t, delivered)
+
+	purged, err := s.PurgeOutbox(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Zero(t, purged)
+	purged, err = s.PurgeOutbox(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), purged)
+	delivered, err = s.IsNotificationDelivered(ctx, key+"/smtp")
+	require.NoError(t, err)
+	require.False(t, delivered)
+	pending, err = s.GetOutbox(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, pending, 3)
+}
+
+// testRetention checks that only the events ended before the date are deleted,
+// series without an end are never deleted.
+func testRetention(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	old := insert(t, s, event(1, "old", 0))
+	current := insert(t, s, event(1, "current", 48))
+	finished := event(1, "finished series", 0)
+	finished.RRule = "FREQ=DAILY;COUNT=2"
+	finished = insert(t, s, finished)
+	running := event(1, "running series", 0)
+	running.RRule = "FREQ=WEEKLY;COUNT=4"
+	running = insert(t, s, running)
+	endless := event(1, "endless series", 0)
+	endless.RRule = "FREQ=DAILY"
+	endless = insert(t, s, endless)
+
+	// the oldest first, a series ends with its last occurrence
+	older, err := s.GetEventsOlderDate(ctx, base.AddDate(0, 0, 2), 0)
+	require.NoError(t, err)
+	require.Equal(t, ids([]model.Event{old, finished}), ids(older))
+	older, err = s.GetEventsOlderDate(ctx, base.AddDate(0, 0, 2), 1)
+	require.NoError(t, err)
+	require.Equal(t, ids([]model.Event{old}), ids(older))
+
+	deleted, err := s.DeleteEventsOlderDate(ctx, old.OffTime)
+	require.NoError(t, err)
+	require.Zero(t, deleted)
+
+	deleted, err = s.DeleteEventsOlderDate(ctx, base.AddDate(0, 0, 2))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), deleted)
+
+	_, err = s.GetEventByID(ctx, old.ID)
+	require.ErrorIs(t, err, storage.ErrEventNotFound)
+	_, err = s.GetEventByID(ctx, finished.ID)
+	require.ErrorIs(t, err, storage.ErrEventNotFound)
+	events, err := s.GetAllEvents(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, ids([]model.Event{current, running, endless}), ids(events))
+	trash, err := s.GetTrash(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, ids([]model.Event{old, finished}), ids(trash))
+
+	// trashed and missing events are skipped
+	deleted, err = s.DeleteEvents(ctx, []int64{current.ID, old.ID, endless.ID + 1000})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), deleted)
+	events, err = s.GetAllEvents(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, ids([]model.Event{running, endless}), ids(events))
+	history, err := s.GetEventHistory(ctx, current.ID)
+	require.NoError(t, err)
+	require.Equal(t, model.AuditDelete, history[len(history)-1].Action)
+}
+
+func testUsers(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	user, err := s.GetUser(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, model.User{ID: 1}, user)
+
+	want := model.User{
+		ID: 1, TimeZone: "Europe/Berlin", ConflictPolicy: model.ConflictPolicyWarn, Email: "user1@example.com",
+		Locale: "ru",
+	}
+	require.NoError(t, s.UpsertUser(ctx, &want))
+	user, err = s.GetUser(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, want, user)
+
+	want.TimeZone = "UTC"
+	require.NoError(t, s.UpsertUser(ctx, &want))
+	user, err = s.GetUser(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, want, user)
+}
+
+func testFeedTokens(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	_, err := s.GetFeedToken(ctx, "missing")
+	require.ErrorIs(t, err, storage.ErrFeedTokenNotFound)
+	require.ErrorIs(t, s.UpdateFeedToken(ctx, &model.FeedToken{Token: "missing"}), storage.ErrFeedTokenNotFound)
+	require.ErrorIs(t, s.DeleteFeedToken(ctx, "missing"), storage.ErrFeedTokenNotFound)
+
+	first := &model.FeedToken{Token: "first", UserID: 1, Created: base}
+	second := &model.FeedToken{Token: "second", UserID: 1, Created: base.Add(time.Hour)}
+	require.NoError(t, s.InsertFeedToken(ctx, second))
+	require.NoError(t, s.InsertFeedToken(ctx, first))
+	require.NoError(t, s.InsertFeedToken(ctx, &model.FeedToken{Token: "other", UserID: 2, Created: base}))
+
+	tokens, err := s.GetFeedTokens(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	require.Equal(t, "first", tokens[0].Token)
+	require.Equal(t, "second", tokens[1].Token)
+
+	first.ETag = `"v1"`
+	first.Modified = base.Add(2 * time.Hour)
+	require.NoError(t, s.UpdateFeedToken(ctx, first))
+	token, err := s.GetFeedToken(ctx, "first")
+	require.NoError(t, err)
+	require.Equal(t, `"v1"`, token.ETag)
+	require.True(t, first.Modified.Equal(token.Modified))
+
+	require.NoError(t, s.DeleteFeedToken(ctx, "first"))
+	_, err = s.GetFeedToken(ctx, "first")
+	require.ErrorIs(t, err, storage.ErrFeedTokenNotFound)
+}
+
+func testCalendars(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	_, err := s.GetCalendar(ctx, missingID)
+	require.ErrorIs(t, err, storage.ErrCalendarNotFound)
+	require.ErrorIs(t, s.UpdateCalendar(ctx, &model.Calendar{ID: missingID, Name: "x"}), storage.ErrCalendarNotFound)
+	require.ErrorIs(t, s.DeleteCalendar(ctx, missingID), storage.ErrCalendarNotFound)
+	require.ErrorIs(t, s.UpsertCalendarShare(ctx, &model.CalendarShare{
+		CalendarID: missingID, UserID: 2, Permission: model.PermissionRead,
+	}), storage.ErrCalendarNotFound)
+
+	c := &model.Calendar{OwnerID: 1, Name: "work"}
+	require.NoError(t, s.InsertCalendar(ctx, c))
+	require.NotZero(t, c.ID)
+	c.Description = "team"
+	c.ConflictPolicy = model.ConflictPolicyAllow
+	require.NoError(t, s.UpdateCalendar(ctx, c))
+	stored, err := s.GetCalendar(ctx, c.ID)
+	require.NoError(t, err)
+	require.Equal(t, "team", stored.Description)
+	require.Equal(t, model.ConflictPolicyAllow, stored.ConflictPolicy)
+
+	_, err = s.GetCalendarShare(ctx, c.ID, 2)
+	require.ErrorIs(t, err, storage.ErrShareNotFound)
+	require.ErrorIs(t, s.DeleteCalendarShare(ctx, c.ID, 2), storage.ErrShareNotFound)
+	share := &model.CalendarShare{CalendarID: c.ID, UserID: 2, Permission: model.PermissionRead}
+	require.NoError(t, s.UpsertCalendarShare(ctx, share))
+	share.Permission = model.PermissionWrite
+	require.NoError(t, s.UpsertCalendarShare(ctx, share))
+	shares, err := s.GetCalendarShares(ctx, c.ID)
+	require.NoError(t, err)
+	require.Equal(t, []model.CalendarShare{*share}, shares)
+
+	calendars, err := s.GetCalendars(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, calendars, 1)
+	require.Equal(t, model.PermissionWrite, calendars[0].Permission)
+	calendars, err = s.GetCalendars(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, calendars, 1)
+	require.Equal(t, model.PermissionOwner, calendars[0].Permission)
+
+	e := event(1, "planning", 0)
+	e.CalendarID = c.ID
+	e = insert(t, s, e)
+	insert(t, s, event(1, "personal", 0))
+	events, err := s.GetCalendarRange(ctx, c.ID, base, base.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, []int64{e.ID}, ids(events))
+
+	// deleting a calendar deletes its events and shares
+	require.NoError(t, s.DeleteCalendar(ctx, c.ID))
+	_, err = s.GetEventByID(ctx, e.ID)
+	require.ErrorIs(t, err, storage.ErrEventNotFound)
+	_, err = s.GetCalendarShare(ctx, c.ID, 2)
+	require.ErrorIs(t, err, storage.ErrShareNotFound)
+	events, err = s.GetAllEvents(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+}
+
+func testAttendees(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	accepted := model.Attendee{UserID: 2, Status: model.PartStatAccepted}
+	require.ErrorIs(t, s.SetAttendee(ctx, missingID, accepted), storage.ErrEventNotFound)
+	require.ErrorIs(t, s.DeleteAttendee(ctx, missingID, 2), storage.ErrEventNotFound)
+
+	e := insert(t, s, event(1, "review", 0))
+	require.ErrorIs(t, s.DeleteAttendee(ctx, e.ID, 2), storage.ErrAttendeeNotFound)
+	require.NoError(t, s.SetAttendee(ctx, e.ID, model.Attendee{UserID: 3, Status: model.PartStatNeedsAction}))
+	require.NoError(t, s.SetAttendee(ctx, e.ID, model.Attendee{UserID: 2, Status: model.PartStatNeedsAction}))
+	require.NoError(t, s.SetAttendee(ctx, e.ID, accepted))
+
+	stored, err := s.GetEventByID(ctx, e.ID)
+	require.NoError(t, err)
+	require.Equal(t, []model.Attendee{accepted, {UserID: 3, Status: model.PartStatNeedsAction}}, stored.Attendees)
+
+	// accepted invitations are in the range of the attendee, the others only in the invitations
+	events, err := s.GetAllRange(ctx, 2, base, base.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, []int64{e.ID}, ids(events))
+	events, err = s.GetAllRange(ctx, 3, base, base.Add(time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, events)
+	invitations, err := s.GetInvitations(ctx, 3)
+	require.NoError(t, err)
+	require.Len(t, invitations, 1)
+	require.Equal(t, model.PartStatNeedsAction, invitations[0].Status)
+
+	// an update keeps the attendees
+	e = stored
+	e.Title = "changed"
+	require.NoError(t, s.UpdateEvent(ctx, &e))
+	stored, err = s.GetEventByID(ctx, e.ID)
+	require.NoError(t, err)
+	require.Len(t, stored.Attendees, 2)
+
+	require.NoError(t, s.DeleteAttendee(ctx, e.ID, 2))
+	events, err = s.GetAllRange(ctx, 2, base, base.Add(time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	// the attendees of a new event are stored with it at the first version
+	invited := event(1, "invited", 3)
+	invited.Attendees = []model.Attendee{{UserID: 3, Status: model.PartStatNeedsAction}, accepted}
+	invited = insert(t, s, invited)
+	require.Equal(t, int64(1), invited.Version)
+	stored, err = s.GetEventByID(ctx, invited.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stored.Version)
+	require.Equal(t, []model.Attendee{accepted, {UserID: 3, Status: model.PartStatNeedsAction}}, stored.Attendees)
+}
+
+func testHistory(t *testing.T, s storage.Storage) {
+	ctx := model.WithActor(context.Background(), "user:1")
+	history, err := s.GetEventHistory(ctx, missingID)
+	require.NoError(t, err)
+	require.Empty(t, history)
+
+	e := event(1, "planned", 0)
+	require.NoError(t, s.InsertEvent(ctx, &e))
+	moved := e
+	moved.Title = "moved"
+	moved.OnTime = moved.OnTime.Add(time.Hour)
+	moved.OffTime = moved.OffTime.Add(time.Hour)
+	require.NoError(t, s.UpdateEvent(ctx, &moved))
+	require.NoError(t, s.SetAttendee(ctx, e.ID, model.Attendee{UserID: 2, Status: model.PartStatAccepted}))
+	require.NoError(t, s.UpdateEventNotified(model.WithActor(ctx, "sender"), e.ID))
+	require.NoError(t, s.DeleteEvent(context.Background(), e.ID))
+
+	history, err = s.GetEventHistory(ctx, e.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 5)
+	actions := make([]model.AuditAction, 0, len(history))
+	for _, entry := range history {
+		require.Equal(t, e.ID, entry.EventID)
+		require.False(t, entry.Time.IsZero())
+		actions = append(actions, entry.Action)
+	}
+	require.Equal(t, []model.AuditAction{
+		model.AuditInsert, model.AuditUpdate, model.AuditUpdate, model.AuditNotified, model.AuditDelete,
+	}, actions)
+
+	insertEntry, update, invite, notified, deleteEntry := history[0], history[1], history[2], history[3], history[4]
+	require.Nil(t, insertEntry.Before)
+	require.Equal(t, "user:1", insertEntry.Actor)
+	require.Equal(t, "planned", insertEntry.After.Title)
+	require.Equal(t, int64(1), insertEntry.After.Version)
+
+	require.Equal(t, "planned", update.Before.Title)
+	require.True(t, e.OnTime.Equal(update.Before.OnTime))
+	require.Equal(t, "moved", update.After.Title)
+	require.True(t, moved.OnTime.Equal(update.After.OnTime))
+	require.Equal(t, int64(2), update.After.Version)
+
+	require.Empty(t, invite.Before.Attendees)
+	require.Equal(t, []model.Attendee{{UserID: 2, Status: model.PartStatAccepted}}, invite.After.Attendees)
+
+	require.Equal(t, "sender", notified.Actor)
+	require.False(t, notified.Before.Notified)
+	require.True(t, notified.After.Notified)
+
+	require.Equal(t, model.ActorAnonymous, deleteEntry.Actor)
+	require.True(t, deleteEntry.Before.Notified)
+	require.Equal(t, "moved", deleteEntry.Before.Title)
+	require.Nil(t, deleteEntry.After)
+
+	// events deleted with their calendar keep their history
+	c := &model.Calendar{OwnerID: 1, Name: "work"}
+	require.NoError(t, s.InsertCalendar(ctx, c))
+	inCalendar := event(1, "in calendar", 2)
+	inCalendar.CalendarID = c.ID
+	inCalendar = insert(t, s, inCalendar)
+	require.NoError(t, s.DeleteCalendar(ctx, c.ID))
+	history, err = s.GetEventHistory(ctx, inCalendar.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, model.AuditDelete, history[1].Action)
+	require.Equal(t, c.ID, history[1].Before.CalendarID)
+}
+
+func testTrash(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	e := event(1, "trashed", 0)
+	e.NotifyTime = e.OnTime.Add(-time.Hour)
+	e = insert(t, s, e)
+	kept := insert(t, s, event(1, "kept", 2))
+
+	require.NoError(t, s.DeleteEvent(ctx, e.ID))
+	require.ErrorIs(t, s.DeleteEvent(ctx, e.ID), storage.ErrEventNotFound)
+
+	// trashed events are left out of every query and change
+	_, err := s.GetEventByID(ctx, e.ID)
+	require.ErrorIs(t, err, storage.ErrEventNotFound)
+	events, err := s.GetAllEvents(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []int64{kept.ID}, ids(events))
+	events, err = s.GetAllRange(ctx, 1, base, base.Add(4*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, []int64{kept.ID}, ids(events))
+	events, err = s.GetOverlaps(ctx, 0, 1, e.OnTime, e.OffTime)
+	require.NoError(t, err)
+	require.Empty(t, events)
+	events, err = s.GetEventsDayOfNotice(ctx, e.OnTime)
+	require.NoError(t, err)
+	require.Empty(t, events)
+	require.ErrorIs(t, s.UpdateEvent(ctx, &e), storage.ErrEventNotFound)
+	require.ErrorIs(t, s.UpdateEventNotified(ctx, e.ID), storage.ErrEventNotFound)
+	require.ErrorIs(t, s.SetAttendee(ctx, e.ID, model.Attendee{UserID: 2}), storage.ErrEventNotFound)
+
+	trash, err := s.GetTrash(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []int64{e.ID}, ids(trash))
+	require.False(t, trash[0].DeletedAt.IsZero())
+	trash, err = s.GetTrash(ctx, 2)
+	require.NoError(t, err)
+	require.Empty(t, trash)
+
+	require.NoError(t, s.RestoreEvent(ctx, e.ID))
+	require.ErrorIs(t, s.RestoreEvent(ctx, e.ID), storage.ErrEventNotFound)
+	require.ErrorIs(t, s.RestoreEvent(ctx, kept.ID), storage.ErrEventNotFound)
+	restored, err := s.GetEventByID(ctx, e.ID)
+	require.NoError(t, err)
+	require.True(t, restored.DeletedAt.IsZero())
+	requireSameEvent(t, e, restored)
+	trash, err = s.GetTrash(ctx, 1)
+	require.NoError(t, err)
+	require.Empty(t, trash)
+
+	require.NoError(t, s.DeleteEvent(ctx, e.ID))
+	purged, err := s.PurgeTrash(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Zero(t, purged)
+	purged, err = s.PurgeTrash(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), purged)
+	trash, err = s.GetTrash(ctx, 1)
+	require.NoError(t, err)
+	require.Empty(t, trash)
+	require.ErrorIs(t, s.RestoreEvent(ctx, e.ID), storage.ErrEventNotFound)
+
+	history, err := s.GetEventHistory(ctx, e.ID)
+	require.NoError(t, err)
+	actions := make([]model.AuditAction, 0, len(history))
+	for _, entry := range history {
+		actions = append(actions, entry.Action)
+	}
+	require.Equal(t, []model.AuditAction{
+		model.AuditInsert, model.AuditDelete, model.AuditRestore, model.AuditDelete, model.AuditPurge,
+	}, actions)
+	require.False(t, history[4].Before.DeletedAt.IsZero())
+	require.Nil(t, history[4].After)
+}
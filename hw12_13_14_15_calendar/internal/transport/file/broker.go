@@ -0,0 +1,284 @@
+package filebroker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/transport/queue"
+)
+
+// pollInterval is how often the consumer looks for notifications when the queue is empty.
+const pollInterval = 500 * time.Millisecond
+
+var ErrCantRecvMsg = errors.New("can't receive message")
+
+type Producer struct {
+	queue dirQueue
+}
+
+func NewProducer(dir string) *Producer {
+	return &Producer{queue: dirQueue{dir: dir}}
+}
+
+func (p *Producer) Connect(ctx context.Context) error {
+	return p.queue.init()
+}
+
+func (p *Producer) Close(ctx context.Context) error {
+	return nil
+}
+
+func (p *Producer) SendNotification(ctx context.Context, msg *model.NotificationMsg) error {
+	env, err := newEnvelope(*msg)
+	if err != nil {
+		return err
+	}
+	if err := p.queue.write(dirReady, time.Now(), env); err != nil {
+		return fmt.Errorf("SendNotification: %w", err)
+	}
+	return nil
+}
+
+// consumers counts the connected consumers of the process, they share its processing directory.
+var consumers atomic.Int64
+
+type Consumer struct {
+	log           Logger
+	queue         dirQueue
+	processing    string
+	conf          queue.Conf
+	notifyChannel chan model.NotificationDelivery
+	done          chan struct{}
+	wg            sync.WaitGroup
+}
+
+func NewConsumer(log Logger, dir string, conf queue.Conf) *Consumer {
+	return &Consumer{
+		log:           log,
+		queue:         dirQueue{dir: dir},
+		processing:    processingDir(os.Getpid()),
+		conf:          conf,
+		notifyChannel: make(chan model.NotificationDelivery, 1),
+		done:          make(chan struct{}),
+	}
+}
+
+// Connect makes the notifications stopped consumers left unsettled ready again and starts consuming.
+func (c *Consumer) Connect(ctx context.Context) error {
+	if err := c.queue.init(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Join(c.queue.dir, c.processing), 0o755); err != nil {
+		return fmt.Errorf("can't create queue directory:%w", err)
+	}
+	first := consumers.Add(1) == 1
+	if err := c.recover(first); err != nil {
+		consumers.Add(-1)
+		return err
+	}
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		c.consume()
+	}()
+	return nil
+}
+
+func (c *Consumer) Close(ctx context.Context) error {
+	close(c.done)
+	c.wg.Wait()
+	consumers.Add(-1)
+	return nil
+}
+
+// recover moves back to ready the notifications claimed by the processes that are gone,
+// and the ones of this process when own is set.
+func (c *Consumer) recover(own bool) error {
+	entries, err := os.ReadDir(filepath.Join(c.queue.dir, dirProcessing))
+	if err != nil {
+		return fmt.Errorf("can't read queue directory:%w", err)
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		self := pid == os.Getpid()
+		if (self && !own) || (!self && alive(pid)) {
+			continue
+		}
+		sub := processingDir(pid)
+		names, err := c.queue.names(sub)
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			if err := c.queue.move(sub, dirReady, name); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("can't recover notification:%w", err)
+			}
+		}
+		if !self {
+			os.Remove(filepath.Join(c.queue.dir, sub))
+		}
+	}
+	return nil
+}
+
+func (c *Consumer) NotifyChannel() <-chan model.NotificationDelivery {
+	return c.notifyChannel
+}
+
+// consume hands the due notifications to the sender one at a time, the next one once the last is settled.
+func (c *Consumer) consume() {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		d, err := c.claim()
+		if err != nil {
+			c.log.Errorf("RecvNotification:%v\n", err)
+		}
+		if d == nil {
+			select {
+			case <-ticker.C:
+				continue
+			case <-c.done:
+				return
+			}
+		}
+		select {
+		case c.notifyChannel <- d:
+		case <-c.done:
+			// the notification stays in processing until a consumer of this process starts again
+			return
+		}
+		select {
+		case <-d.settled:
+		case <-c.done:
+			return
+		}
+	}
+}
+
+// claim moves the oldest due notification to processing, it returns nil when none is due.
+func (c *Consumer) claim() (*delivery, error) {
+	names, err := c.queue.names(dirReady)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for _, name := range names {
+		if due(name).After(now) {
+			return nil, nil
+		}
+		// another consumer claimed it first
+		if err := c.queue.move(dirReady, c.processing, name); err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+		env, _, err := c.queue.read(c.processing, name)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			// not an envelope, the file is kept as it is
+			c.log.Errorf("RecvNotification:%v\n", err)
+			if err := c.queue.move(c.processing, dirDead, name); err != nil {
+				c.log.Errorf("Can't dead-letter notification:%v\n", err)
+			}
+			continue
+		}
+		var msg model.NotificationMsg
+		if err := json.Unmarshal(env.Body, &msg); err != nil {
+			c.log.Errorf("RecvNotification:%v\n", err)
+			if err := c.queue.deadLetter(c.processing, name, env, fmt.Errorf("%w:%w", ErrCantRecvMsg, err)); err != nil {
+				c.log.Errorf("Can't dead-letter notification:%v\n", err)
+			}
+			continue
+		}
+		c.log.Debugf("Received notification: %v\n", msg)
+		return &delivery{consumer: c, name: name, env: env, msg: msg, settled: make(chan struct{})}, nil
+	}
+	return nil, nil
+}
+
+// retry writes the notification back to the queue due after the delay of its next retry,
+// a notification without retries left is dead-lettered.
+func (c *Consumer) retry(d *delivery, reason error) error {
+	retry := d.env.Retries + 1
+	if retry > c.conf.Limit() {
+		if err := c.queue.deadLetter(c.processing, d.name, d.env, reason); err != nil {
+			return err
+		}
+		c.log.Warningf("Notification dead-lettered:%v\n", reason)
+		return nil
+	}
+	delay := c.conf.Delay(retry)
+	env := d.env
+	env.Retries, env.Error, env.Timestamp = retry, reason.Error(), time.Now().UTC()
+	if err := c.queue.write(dirReady, time.Now().Add(delay), env); err != nil {
+		return err
+	}
+	c.log.Debugf("Notification retry:%v in %v\n", retry, delay)
+	return os.Remove(c.queue.path(c.processing, d.name))
+}
+
+// delivery is a claimed notification settled by the sender.
+type delivery struct {
+	consumer *Consumer
+	name     string
+	env      envelope
+	msg      model.NotificationMsg
+	settled  chan struct{}
+	once     sync.Once
+}
+
+func (d *delivery) Msg() model.NotificationMsg {
+	return d.msg
+}
+
+func (d *delivery) Ack() error {
+	defer d.settle()
+	return os.Remove(d.consumer.queue.path(d.consumer.processing, d.name))
+}
+
+func (d *delivery) Retry(reason error) error {
+	defer d.settle()
+	return d.consumer.retry(d, reason)
+}
+
+func (d *delivery) settle() {
+	d.once.Do(func() {
+		close(d.settled)
+	})
+}
+
+// processingDir is the directory in processing of the notifications claimed by the consumers of a process.
+func processingDir(pid int) string {
+	return filepath.Join(dirProcessing, strconv.Itoa(pid))
+}
+
+// alive reports whether a process with the pid runs on this host.
+func alive(pid int) bool {
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	err = p.Signal(syscall.Signal(0))
+	return err == nil || errors.Is(err, os.ErrPermission)
+}
@@ -0,0 +1,135 @@
+package filebroker
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/logger"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/transport/queue"
+	"github.com/stretchr/testify/require"
+)
+
+func receive(t *testing.T, c *Consumer) model.NotificationDelivery {
+	t.Helper()
+	select {
+	case d := <-c.NotifyChannel():
+		return d
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no notification received")
+		return nil
+	}
+}
+
+func files(t *testing.T, dir, sub string) int {
+	t.Helper()
+	entries, err := os.ReadDir(filepath.Join(dir, sub))
+	require.NoError(t, err)
+	return len(entries)
+}
+
+func TestBroker(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	log := logger.NewLogger("DEBUG", os.Stdout)
+	conf := queue.Conf{MaxRetries: 1, RetryDelay: time.Millisecond}
+
+	producer := NewProducer(dir)
+	require.NoError(t, producer.Connect(ctx))
+	first := model.NotificationMsg{ID: 1, Title: "first", Date: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), UserID: 2}
+	second := model.NotificationMsg{ID: 3, Title: "second", Date: first.Date, UserID: 4, Key: "3:0:4"}
+	require.NoError(t, producer.SendNotification(ctx, &first))
+	require.NoError(t, producer.SendNotification(ctx, &second))
+	require.Equal(t, 2, files(t, dir, dirReady))
+
+	// an unsettled notification is delivered again after a restart
+	consumer := NewConsumer(log, dir, conf)
+	require.NoError(t, consumer.Connect(ctx))
+	require.Equal(t, first, receive(t, consumer).Msg())
+	require.NoError(t, consumer.Close(ctx))
+	require.Equal(t, 1, files(t, dir, consumer.processing))
+
+	consumer = NewConsumer(log, dir, conf)
+	require.NoError(t, consumer.Connect(ctx))
+	defer consumer.Close(ctx)
+	d := receive(t, consumer)
+	require.Equal(t, first, d.Msg())
+	require.NoError(t, d.Ack())
+	d = receive(t, consumer)
+	require.Equal(t, second, d.Msg())
+	require.NoError(t, d.Ack())
+	require.Zero(t, files(t, dir, consumer.processing))
+
+	// a notification is retried once, then dead-lettered
+	require.NoError(t, producer.SendNotification(ctx, &first))
+	require.NoError(t, receive(t, consumer).Retry(errors.New("down")))
+	d = receive(t, consumer)
+	require.Equal(t, first, d.Msg())
+	require.NoError(t, d.Retry(errors.New("still down")))
+
+	dead := NewDeadLetters(dir)
+	require.NoError(t, dead.Connect(ctx))
+	letters, err := dead.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, letters, 1)
+	require.Equal(t, first, letters[0].Msg)
+	require.Equal(t, 1, letters[0].Retries)
+	require.Equal(t, "still down", letters[0].Error)
+
+	moved, err := dead.Requeue(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, moved)
+	require.Equal(t, first, receive(t, consumer).Msg())
+	require.Zero(t, files(t, dir, dirDead))
+}
+
+func TestBrokerPoison(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	log := logger.NewLogger("DEBUG", os.Stdout)
+	consumer := NewConsumer(log, dir, queue.Conf{})
+	require.NoError(t, consumer.Connect(ctx))
+	defer consumer.Close(ctx)
+
+	q := dirQueue{dir: dir}
+	require.NoError(t, q.write(dirReady, time.Now(), envelope{Body: []byte(`"not a notification"`)}))
+	require.NoError(t, os.WriteFile(q.path(dirReady, "00000000000000000001-1-1.json"), []byte("{"), 0o600))
+
+	require.Eventually(t, func() bool {
+		return files(t, dir, dirDead) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	letters, err := NewDeadLetters(dir).List(ctx)
+	require.NoError(t, err)
+	require.Len(t, letters, 2)
+	require.Equal(t, []byte("{"), letters[0].Body)
+	require.Contains(t, letters[1].Error, ErrCantRecvMsg.Error())
+}
+
+func TestBrokerRecovery(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	log := logger.NewLogger("DEBUG", os.Stdout)
+	q := dirQueue{dir: dir}
+	require.NoError(t, q.init())
+	env, err := newEnvelope(model.NotificationMsg{ID: 1, Title: "claimed"})
+	require.NoError(t, err)
+
+	// the notification of a running process stays claimed, the one of a process that is gone is ready again
+	running, gone := processingDir(os.Getppid()), processingDir(1<<30)
+	for _, sub := range []string{running, gone} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, sub), 0o755))
+		require.NoError(t, q.write(sub, time.Now(), env))
+	}
+	consumer := NewConsumer(log, dir, queue.Conf{})
+	require.NoError(t, consumer.Connect(ctx))
+	defer consumer.Close(ctx)
+	d := receive(t, consumer)
+	require.Equal(t, int64(1), d.Msg().ID)
+	require.NoError(t, d.Ack())
+	require.Equal(t, 1, files(t, dir, running))
+	require.NoDirExists(t, filepath.Join(dir, gone))
+}
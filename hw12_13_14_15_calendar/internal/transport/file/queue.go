@@ -0,0 +1,257 @@
+// Package filebroker keeps the notifications as files of a directory, so the scheduler and the sender
+// of one host exchange them without an external broker and none is lost when they restart.
+//
+// A notification is written to tmp and renamed into ready under a name ordered by the time it is due.
+// The consumer claims it by renaming it into the directory of its process in processing and removes it
+// once the sender settles it. Several consumers may share the queue: one starting makes ready again only
+// the notifications left by the processes that are gone, and by its own process when no other consumer
+// of the process runs.
+// The notifications without retries left are moved to dead.
+package filebroker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/transport/queue"
+)
+
+const (
+	dirTmp        = "tmp"
+	dirReady      = "ready"
+	dirProcessing = "processing"
+	dirDead       = "dead"
+)
+
+type Logger interface {
+	Errorf(format string, a ...interface{})
+	Warningf(format string, a ...interface{})
+	Debugf(format string, a ...interface{})
+}
+
+// envelope is the content of a file, Body is the notification.
+type envelope struct {
+	Body      json.RawMessage `json:"body"`
+	Retries   int             `json:"retries,omitempty"`
+	Error     string          `json:"error,omitempty"`
+	Timestamp time.Time       `json:"timestamp"`
+}
+
+// seq tells apart the files a process writes at the same time.
+var seq atomic.Int64
+
+// dirQueue is the directory of the queue.
+type dirQueue struct {
+	dir string
+}
+
+func (q dirQueue) path(sub, name string) string {
+	return filepath.Join(q.dir, sub, name)
+}
+
+// init creates the directories of the queue.
+func (q dirQueue) init() error {
+	for _, sub := range []string{dirTmp, dirReady, dirProcessing, dirDead} {
+		if err := os.MkdirAll(filepath.Join(q.dir, sub), 0o755); err != nil {
+			return fmt.Errorf("can't create queue directory:%w", err)
+		}
+	}
+	return nil
+}
+
+// write stores env in sub under a name ordered by due, the file appears complete or not at all.
+func (q dirQueue) write(sub string, due time.Time, env envelope) error {
+	data, err := json.Marshal(env)
+	if err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%020d-%d-%d.json", due.UnixNano(), os.Getpid(), seq.Add(1))
+	tmp := q.path(dirTmp, name)
+	if err := writeFile(tmp, data); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("can't write notification:%w", err)
+	}
+	if err := os.Rename(tmp, q.path(sub, name)); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("can't write notification:%w", err)
+	}
+	return syncDir(filepath.Join(q.dir, sub))
+}
+
+// read returns the envelope of a file, the raw content when it is not an envelope.
+func (q dirQueue) read(sub, name string) (envelope, []byte, error) {
+	var env envelope
+	data, err := os.ReadFile(q.path(sub, name))
+	if err != nil {
+		return env, nil, err
+	}
+	if err := json.Unmarshal(data, &env); err != nil {
+		return env, data, fmt.Errorf("wrong notification file %v:%w", name, err)
+	}
+	return env, data, nil
+}
+
+// names returns the files of sub in the order they are due.
+func (q dirQueue) names(sub string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(q.dir, sub))
+	if err != nil {
+		return nil, fmt.Errorf("can't read queue directory:%w", err)
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".json") {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// move renames a file from one directory of the queue to another, it fails when the file is gone.
+func (q dirQueue) move(from, to, name string) error {
+	if err := os.Rename(q.path(from, name), q.path(to, name)); err != nil {
+		return err
+	}
+	return syncDir(filepath.Join(q.dir, to))
+}
+
+// deadLetter replaces a file of sub with a dead letter keeping the reason.
+func (q dirQueue) deadLetter(sub, name string, env envelope, reason error) error {
+	env.Error = reason.Error()
+	env.Timestamp = time.Now().UTC()
+	if err := q.write(dirDead, env.Timestamp, env); err != nil {
+		return err
+	}
+	return os.Remove(q.path(sub, name))
+}
+
+// due returns the time a file is due from its name.
+func due(name string) time.Time {
+	prefix, _, _ := strings.Cut(name, "-")
+	nanos, err := strconv.ParseInt(prefix, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(0, nanos)
+}
+
+func newEnvelope(msg model.NotificationMsg) (envelope, error) {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return envelope{}, err
+	}
+	return envelope{Body: body, Timestamp: time.Now().UTC()}, nil
+}
+
+func writeFile(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// syncDir makes a rename into dir durable.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	if err := d.Sync(); err != nil && !errors.Is(err, os.ErrInvalid) {
+		return fmt.Errorf("can't sync queue directory:%w", err)
+	}
+	return nil
+}
+
+// DeadLetters inspects and requeues the dead-lettered notifications.
+type DeadLetters struct {
+	queue dirQueue
+}
+
+func NewDeadLetters(dir string) *DeadLetters {
+	return &DeadLetters{queue: dirQueue{dir: dir}}
+}
+
+func (d *DeadLetters) Connect(ctx context.Context) error {
+	return d.queue.init()
+}
+
+func (d *DeadLetters) Close(ctx context.Context) error {
+	return nil
+}
+
+// List returns the dead-lettered notifications from the oldest.
+func (d *DeadLetters) List(ctx context.Context) ([]queue.DeadLetter, error) {
+	names, err := d.queue.names(dirDead)
+	if err != nil {
+		return nil, err
+	}
+	letters := make([]queue.DeadLetter, 0, len(names))
+	for _, name := range names {
+		env, data, err := d.queue.read(dirDead, name)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		letter := queue.DeadLetter{Body: data, Timestamp: due(name)}
+		if err == nil {
+			letter.Body = env.Body
+			letter.Retries = env.Retries
+			letter.Error = env.Error
+			letter.Timestamp = env.Timestamp
+			json.Unmarshal(env.Body, &letter.Msg)
+		}
+		letters = append(letters, letter)
+	}
+	return letters, nil
+}
+
+// Requeue moves up to limit dead-lettered notifications, all when limit is not positive, back to the
+// queue with their retries reset and returns how many were moved.
+func (d *DeadLetters) Requeue(ctx context.Context, limit int) (int, error) {
+	names, err := d.queue.names(dirDead)
+	if err != nil {
+		return 0, err
+	}
+	moved := 0
+	for _, name := range names {
+		if limit > 0 && moved == limit {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return moved, err
+		}
+		// a file that is not a notification stays dead
+		env, _, err := d.queue.read(dirDead, name)
+		if err != nil {
+			continue
+		}
+		env.Retries, env.Error, env.Timestamp = 0, "", time.Now().UTC()
+		if err := d.queue.write(dirReady, time.Now(), env); err != nil {
+			return moved, fmt.Errorf("can't requeue dead letter:%w", err)
+		}
+		if err := os.Remove(d.queue.path(dirDead, name)); err != nil {
+			return moved, fmt.Errorf("can't remove dead letter:%w", err)
+		}
+		moved++
+	}
+	return moved, nil
+}
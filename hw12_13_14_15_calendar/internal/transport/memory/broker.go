@@ -0,0 +1,215 @@
+// Package memorybroker passes the notifications through a channel of the process,
+// so a scheduler and a sender running in one process, as in the tests, need no external broker.
+// The notifications are lost when the process stops.
+package memorybroker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/transport/queue"
+)
+
+// capacity is how many notifications wait for the sender before the scheduler blocks.
+const capacity = 1024
+
+var (
+	ErrClosed = errors.New("broker closed")
+	ErrFull   = errors.New("broker full")
+)
+
+// Broker is the queue of notifications the scheduler publishes to, it keeps the dead letters of its consumers.
+type Broker struct {
+	messages  chan message
+	done      chan struct{}
+	closeOnce sync.Once
+	mu        sync.Mutex
+	dead      []queue.DeadLetter
+}
+
+// message is a published notification with the retries it went through.
+type message struct {
+	msg     model.NotificationMsg
+	retries int
+}
+
+func New() *Broker {
+	return &Broker{messages: make(chan message, capacity), done: make(chan struct{})}
+}
+
+func (b *Broker) Connect(ctx context.Context) error {
+	return nil
+}
+
+// Close stops the broker, the retries still waiting are dropped.
+func (b *Broker) Close(ctx context.Context) error {
+	b.closeOnce.Do(func() {
+		close(b.done)
+	})
+	return nil
+}
+
+// SendNotification fails at once when the sender lags behind, the scheduler publishes msg again later.
+func (b *Broker) SendNotification(ctx context.Context, msg *model.NotificationMsg) error {
+	select {
+	case <-b.done:
+		return ErrClosed
+	default:
+	}
+	select {
+	case b.messages <- message{msg: *msg}:
+		return nil
+	default:
+		return ErrFull
+	}
+}
+
+func (b *Broker) publish(ctx context.Context, m message) error {
+	select {
+	case <-b.done:
+		return ErrClosed
+	default:
+	}
+	select {
+	case b.messages <- m:
+		return nil
+	case <-b.done:
+		return ErrClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *Broker) deadLetter(m message, reason error) {
+	body, _ := json.Marshal(m.msg)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.dead = append(b.dead, queue.DeadLetter{
+		Msg:       m.msg,
+		Body:      body,
+		Retries:   m.retries,
+		Error:     reason.Error(),
+		Timestamp: time.Now(),
+	})
+}
+
+// List returns the dead-lettered notifications from the oldest.
+func (b *Broker) List(ctx context.Context) ([]queue.DeadLetter, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	letters := make([]queue.DeadLetter, len(b.dead))
+	copy(letters, b.dead)
+	return letters, nil
+}
+
+// Requeue publishes up to limit dead-lettered notifications, all when limit is not positive,
+// again with their retries reset and returns how many were moved.
+func (b *Broker) Requeue(ctx context.Context, limit int) (int, error) {
+	moved := 0
+	for limit <= 0 || moved < limit {
+		b.mu.Lock()
+		if len(b.dead) == 0 {
+			b.mu.Unlock()
+			break
+		}
+		letter := b.dead[0]
+		b.dead = b.dead[1:]
+		b.mu.Unlock()
+
+		if err := b.publish(ctx, message{msg: letter.Msg}); err != nil {
+			b.mu.Lock()
+			b.dead = append([]queue.DeadLetter{letter}, b.dead...)
+			b.mu.Unlock()
+			return moved, err
+		}
+		moved++
+	}
+	return moved, nil
+}
+
+// Consumer receives the notifications of a broker, conf bounds their retries.
+type Consumer struct {
+	broker        *Broker
+	conf          queue.Conf
+	notifyChannel chan model.NotificationDelivery
+	done          chan struct{}
+	closeOnce     sync.Once
+}
+
+func NewConsumer(broker *Broker, conf queue.Conf) *Consumer {
+	return &Consumer{
+		broker:        broker,
+		conf:          conf,
+		notifyChannel: make(chan model.NotificationDelivery, 1),
+		done:          make(chan struct{}),
+	}
+}
+
+func (c *Consumer) Connect(ctx context.Context) error {
+	go func() {
+		for {
+			select {
+			case m := <-c.broker.messages:
+				select {
+				case c.notifyChannel <- &delivery{consumer: c, message: m}:
+				case <-c.done:
+					// the notification goes back for another consumer
+					c.broker.publish(context.Background(), m)
+					return
+				}
+			case <-c.done:
+				return
+			case <-c.broker.done:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (c *Consumer) Close(ctx context.Context) error {
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+	return nil
+}
+
+func (c *Consumer) NotifyChannel() <-chan model.NotificationDelivery {
+	return c.notifyChannel
+}
+
+// retry publishes m again once the delay of its next retry passed, m without retries left is dead-lettered.
+func (c *Consumer) retry(m message, reason error) error {
+	retry := m.retries + 1
+	if retry > c.conf.Limit() {
+		c.broker.deadLetter(m, reason)
+		return nil
+	}
+	m.retries = retry
+	time.AfterFunc(c.conf.Delay(m.retries), func() {
+		c.broker.publish(context.Background(), m)
+	})
+	return nil
+}
+
+// delivery is a received notification settled by the sender.
+type delivery struct {
+	consumer *Consumer
+	message  message
+}
+
+func (d *delivery) Msg() model.NotificationMsg {
+	return d.message.msg
+}
+
+func (d *delivery) Ack() error {
+	return nil
+}
+
+func (d *delivery) Retry(reason error) error {
+	return d.consumer.retry(d.message, reason)
+}
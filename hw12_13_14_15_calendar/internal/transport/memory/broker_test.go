@@ -0,0 +1,69 @@
+package memorybroker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/transport/queue"
+	"github.com/stretchr/testify/require"
+)
+
+func receive(t *testing.T, c *Consumer) model.NotificationDelivery {
+	t.Helper()
+	select {
+	case d := <-c.NotifyChannel():
+		return d
+	case <-time.After(time.Second):
+		require.FailNow(t, "no notification received")
+		return nil
+	}
+}
+
+func TestBroker(t *testing.T) {
+	ctx := context.Background()
+	broker := New()
+	consumer := NewConsumer(broker, queue.Conf{MaxRetries: 1, RetryDelay: time.Millisecond})
+	require.NoError(t, consumer.Connect(ctx))
+	defer consumer.Close(ctx)
+
+	msg := model.NotificationMsg{ID: 1, Title: "memory", UserID: 2, Key: "1:0:2"}
+	require.NoError(t, broker.SendNotification(ctx, &msg))
+	d := receive(t, consumer)
+	require.Equal(t, msg, d.Msg())
+	require.NoError(t, d.Ack())
+
+	// a notification is retried once, then dead-lettered
+	require.NoError(t, broker.SendNotification(ctx, &msg))
+	require.NoError(t, receive(t, consumer).Retry(errors.New("down")))
+	require.NoError(t, receive(t, consumer).Retry(errors.New("still down")))
+	letters, err := broker.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, letters, 1)
+	require.Equal(t, msg, letters[0].Msg)
+	require.Equal(t, 1, letters[0].Retries)
+	require.Equal(t, "still down", letters[0].Error)
+
+	moved, err := broker.Requeue(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, moved)
+	require.Equal(t, msg, receive(t, consumer).Msg())
+	letters, err = broker.List(ctx)
+	require.NoError(t, err)
+	require.Empty(t, letters)
+
+	require.NoError(t, broker.Close(ctx))
+	require.ErrorIs(t, broker.SendNotification(ctx, &msg), ErrClosed)
+}
+
+func TestBrokerFull(t *testing.T) {
+	ctx := context.Background()
+	broker := New()
+	msg := model.NotificationMsg{ID: 1}
+	for i := 0; i < capacity; i++ {
+		require.NoError(t, broker.SendNotification(ctx, &msg))
+	}
+	require.ErrorIs(t, broker.SendNotification(ctx, &msg), ErrFull)
+}
@@ -0,0 +1,58 @@
+// Package queue holds what the brokers of notifications share: the retry policy
+// of the notifications the sender failed to process and their dead letters.
+package queue
+
+import (
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+)
+
+const (
+	DefaultMaxRetries    = 5
+	DefaultRetryDelay    = 10 * time.Second
+	DefaultMaxRetryDelay = 10 * time.Minute
+)
+
+// Conf bounds the redelivery of the notifications the sender failed to process.
+// The n-th retry waits RetryDelay*2^(n-1), at most MaxRetryDelay, then the notification is dead-lettered.
+type Conf struct {
+	MaxRetries    int           `toml:"max_retries"`
+	RetryDelay    time.Duration `toml:"retry_delay"`
+	MaxRetryDelay time.Duration `toml:"max_retry_delay"`
+}
+
+// Limit is how many times a notification is retried before it is dead-lettered.
+func (c Conf) Limit() int {
+	if c.MaxRetries <= 0 {
+		return DefaultMaxRetries
+	}
+	return c.MaxRetries
+}
+
+// Delay is the wait before the retry number retry, counted from 1.
+func (c Conf) Delay(retry int) time.Duration {
+	delay, maxDelay := c.RetryDelay, c.MaxRetryDelay
+	if delay <= 0 {
+		delay = DefaultRetryDelay
+	}
+	if maxDelay <= 0 {
+		maxDelay = DefaultMaxRetryDelay
+	}
+	for i := 1; i < retry && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
+
+// DeadLetter is a dead-lettered notification, Msg is zero when the body is not a notification.
+type DeadLetter struct {
+	Msg       model.NotificationMsg
+	Body      []byte
+	Retries   int
+	Error     string
+	Timestamp time.Time
+}
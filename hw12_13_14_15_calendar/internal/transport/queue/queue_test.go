@@ -0,0 +1,22 @@
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelay(t *testing.T) {
+	conf := Conf{}
+	require.Equal(t, DefaultMaxRetries, conf.Limit())
+	require.Equal(t, 10*time.Second, conf.Delay(1))
+	require.Equal(t, 20*time.Second, conf.Delay(2))
+	require.Equal(t, 160*time.Second, conf.Delay(5))
+	require.Equal(t, 10*time.Minute, conf.Delay(10))
+
+	conf = Conf{MaxRetries: 3, RetryDelay: time.Second, MaxRetryDelay: 3 * time.Second}
+	require.Equal(t, 3, conf.Limit())
+	require.Equal(t, 2*time.Second, conf.Delay(2))
+	require.Equal(t, 3*time.Second, conf.Delay(3))
+}
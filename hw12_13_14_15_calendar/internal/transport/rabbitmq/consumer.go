@@ -4,26 +4,32 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
+// prefetch is how many unacknowledged notifications the broker hands to the sender at once.
+const prefetch = 1
+
 type Consumer struct {
 	log             Logger
 	url             string
+	conf            QueueConf
 	channel         *amqp.Channel
 	connect         *amqp.Connection
 	queue           amqp.Queue
 	deliveryChannel <-chan amqp.Delivery
-	notifyChannel   chan model.NotificationMsg
+	notifyChannel   chan model.NotificationDelivery
 }
 
 var ErrCantRecvMsg = errors.New("can't receive message")
 
-func NewConsumer(log Logger, url string) *Consumer {
-	notifyChannel := make(chan model.NotificationMsg, 1)
-	return &Consumer{log: log, url: url, notifyChannel: notifyChannel}
+func NewConsumer(log Logger, url string, conf QueueConf) *Consumer {
+	notifyChannel := make(chan model.NotificationDelivery, 1)
+	return &Consumer{log: log, url: url, conf: conf, notifyChannel: notifyChannel}
 }
 
 func (c *Consumer) Connect(ctx context.Context) error {
@@ -39,12 +45,17 @@ func (c *Consumer) Connect(ctx context.Context) error {
 		return err
 	}
 
-	c.queue, err = c.channel.QueueDeclare(getQueueDeclated())
+	c.queue, err = declareTopology(c.channel, c.conf)
 	if err != nil {
 		return err
 	}
 
-	c.deliveryChannel, err = c.channel.Consume(c.queue.Name, "", true, false, false, false, nil)
+	if err := c.channel.Qos(prefetch, 0, false); err != nil {
+		return err
+	}
+
+	// a notification stays with the broker until the sender settles it
+	c.deliveryChannel, err = c.channel.Consume(c.queue.Name, "", false, false, false, false, nil)
 	if err != nil {
 		return err
 	}
@@ -54,10 +65,13 @@ func (c *Consumer) Connect(ctx context.Context) error {
 			notify, err := c.unpackMsg(msg)
 			if err != nil {
 				c.log.Errorf("RecvNotification:%v\n", err)
-			} else {
-				c.notifyChannel <- notify
-				c.log.Debugf("Received notification: %v\n", notify)
+				if err := c.deadLetter(msg, fmt.Errorf("%w:%w", ErrCantRecvMsg, err)); err != nil {
+					c.log.Errorf("Can't dead-letter notification:%v\n", err)
+				}
+				continue
 			}
+			c.notifyChannel <- &delivery{consumer: c, delivery: msg, msg: notify}
+			c.log.Debugf("Received notification: %v\n", notify)
 		}
 	}()
 
@@ -66,7 +80,7 @@ func (c *Consumer) Connect(ctx context.Context) error {
 	return nil
 }
 
-func (c *Consumer) NotifyChannel() <-chan model.NotificationMsg {
+func (c *Consumer) NotifyChannel() <-chan model.NotificationDelivery {
 	return c.notifyChannel
 }
 
@@ -84,3 +98,64 @@ func (c *Consumer) unpackMsg(msg amqp.Delivery) (model.NotificationMsg, error) {
 	}
 	return notify, nil
 }
+
+// retry publishes msg to the delay queue of its next retry and acknowledges it,
+// a msg without retries left is dead-lettered.
+func (c *Consumer) retry(msg amqp.Delivery, reason error) error {
+	retry := retries(msg.Headers) + 1
+	if retry > c.conf.Limit() {
+		return c.deadLetter(msg, reason)
+	}
+	delay := c.conf.Delay(retry)
+	if err := c.republish(msg, "", retryQueue(delay), withHeaders(msg.Headers, retry, reason)); err != nil {
+		return err
+	}
+	c.log.Debugf("Notification retry:%v in %v\n", retry, delay)
+	return nil
+}
+
+// deadLetter publishes msg to the dead-letter exchange with the reason and acknowledges it.
+func (c *Consumer) deadLetter(msg amqp.Delivery, reason error) error {
+	if err := c.republish(msg, deadExchange, "", withHeaders(msg.Headers, retries(msg.Headers), reason)); err != nil {
+		return err
+	}
+	c.log.Warningf("Notification dead-lettered:%v\n", reason)
+	return nil
+}
+
+// republish moves msg, it goes back to the queue at once when it can't be published.
+func (c *Consumer) republish(msg amqp.Delivery, exchange, key string, headers amqp.Table) error {
+	pub := amqp.Publishing{
+		Headers:      headers,
+		ContentType:  msg.ContentType,
+		DeliveryMode: amqp.Persistent,
+		Timestamp:    time.Now(),
+		Body:         msg.Body,
+	}
+	if err := c.channel.PublishWithContext(context.Background(), exchange, key, false, false, pub); err != nil {
+		if nackErr := msg.Nack(false, true); nackErr != nil {
+			c.log.Errorf("Can't requeue notification:%v\n", nackErr)
+		}
+		return fmt.Errorf("can't republish notification:%w", err)
+	}
+	return msg.Ack(false)
+}
+
+// delivery is a received notification settled by the sender.
+type delivery struct {
+	consumer *Consumer
+	delivery amqp.Delivery
+	msg      model.NotificationMsg
+}
+
+func (d *delivery) Msg() model.NotificationMsg {
+	return d.msg
+}
+
+func (d *delivery) Ack() error {
+	return d.delivery.Ack(false)
+}
+
+func (d *delivery) Retry(reason error) error {
+	return d.consumer.retry(d.delivery, reason)
+}
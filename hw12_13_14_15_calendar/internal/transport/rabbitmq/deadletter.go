@@ -0,0 +1,119 @@
+package internalrmq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/transport/queue"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// DeadLetter is a dead-lettered notification, Msg is zero when the body is not a notification.
+type DeadLetter = queue.DeadLetter
+
+// DeadLetters inspects and requeues the dead-lettered notifications.
+type DeadLetters struct {
+	log     Logger
+	url     string
+	conf    QueueConf
+	channel *amqp.Channel
+	connect *amqp.Connection
+}
+
+func NewDeadLetters(log Logger, url string, conf QueueConf) *DeadLetters {
+	return &DeadLetters{log: log, url: url, conf: conf}
+}
+
+func (d *DeadLetters) Connect(ctx context.Context) error {
+	var err error
+	d.connect, err = amqp.Dial(d.url)
+	if err != nil {
+		return err
+	}
+	d.channel, err = d.connect.Channel()
+	if err != nil {
+		return err
+	}
+	_, err = declareTopology(d.channel, d.conf)
+	return err
+}
+
+func (d *DeadLetters) Close(ctx context.Context) error {
+	d.connect.Close()
+	d.channel.Close()
+	return nil
+}
+
+// List returns the dead-lettered notifications from the oldest, they stay in the queue.
+func (d *DeadLetters) List(ctx context.Context) ([]DeadLetter, error) {
+	msgs, err := d.get(ctx, 0)
+	// the channel holds the messages unacknowledged until they are all read, so none is read twice
+	for _, msg := range msgs {
+		if nackErr := msg.Nack(false, true); nackErr != nil && err == nil {
+			err = fmt.Errorf("can't return dead letter:%w", nackErr)
+		}
+	}
+	letters := make([]DeadLetter, 0, len(msgs))
+	for _, msg := range msgs {
+		letter := DeadLetter{
+			Body:      msg.Body,
+			Retries:   retries(msg.Headers),
+			Timestamp: msg.Timestamp,
+		}
+		json.Unmarshal(msg.Body, &letter.Msg)
+		if reason, ok := msg.Headers[headerError].(string); ok {
+			letter.Error = reason
+		}
+		letters = append(letters, letter)
+	}
+	return letters, err
+}
+
+// Requeue moves up to limit dead-lettered notifications, all when limit is not positive, back to the
+// notification queue with their retries reset and returns how many were moved.
+func (d *DeadLetters) Requeue(ctx context.Context, limit int) (int, error) {
+	msgs, err := d.get(ctx, limit)
+	if err != nil {
+		for _, msg := range msgs {
+			msg.Nack(false, true)
+		}
+		return 0, err
+	}
+	moved := 0
+	for _, msg := range msgs {
+		pub := amqp.Publishing{
+			Headers:     withHeaders(msg.Headers, 0, nil),
+			ContentType: msg.ContentType,
+			Body:        msg.Body,
+		}
+		if err := d.channel.PublishWithContext(ctx, "", queueName, false, false, pub); err != nil {
+			msg.Nack(false, true)
+			return moved, fmt.Errorf("can't requeue dead letter:%w", err)
+		}
+		if err := msg.Ack(false); err != nil {
+			return moved, fmt.Errorf("can't remove dead letter:%w", err)
+		}
+		moved++
+	}
+	return moved, nil
+}
+
+// get reads up to limit messages of the dead-letter queue without acknowledging them.
+func (d *DeadLetters) get(ctx context.Context, limit int) ([]amqp.Delivery, error) {
+	var msgs []amqp.Delivery
+	for limit <= 0 || len(msgs) < limit {
+		if err := ctx.Err(); err != nil {
+			return msgs, err
+		}
+		msg, ok, err := d.channel.Get(deadQueue, false)
+		if err != nil {
+			return msgs, fmt.Errorf("can't read dead letter:%w", err)
+		}
+		if !ok {
+			break
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
@@ -56,8 +56,12 @@ func (c *Producer) Connect(ctx context.Context) error {
 }
 
 func (c *Producer) Close(ctx context.Context) error {
-	c.connect.Close()
-	c.channel.Close()
+	if c.channel != nil {
+		c.channel.Close()
+	}
+	if c.connect != nil {
+		c.connect.Close()
+	}
 	return nil
 }
 
@@ -70,21 +74,25 @@ func (c *Producer) Stop(ctx context.Context) error {
 	return nil
 }
 
-func (c *Producer) SendNotification(ctx context.Context, event *model.Event) error {
-	msg := model.NotificationMsg{
-		ID:     event.ID,
-		Title:  event.Title,
-		Date:   event.OnTime,
-		UserID: event.UserID,
-	}
-
+func (c *Producer) SendNotification(ctx context.Context, msg *model.NotificationMsg) error {
 	jdata, err := json.Marshal(msg)
 	if err != nil {
 		return err
 	}
 
+	// the broker dropped the channel, the outbox keeps the notification until it is reconnected
+	if c.channel == nil || c.channel.IsClosed() {
+		if c.connect != nil {
+			c.connect.Close()
+		}
+		if err := c.Connect(ctx); err != nil {
+			return fmt.Errorf("SendNotification: %w", err)
+		}
+	}
+
 	pub := amqp.Publishing{
 		ContentType: "application/json",
+		MessageId:   msg.Key,
 		Body:        jdata,
 	}
 
@@ -94,7 +102,3 @@ func (c *Producer) SendNotification(ctx context.Context, event *model.Event) err
 	c.log.Debugf("sent notification msg\n")
 	return nil
 }
-
-func getQueueDeclated() (string, bool, bool, bool, bool, amqp.Table) {
-	return "notification", false, false, false, false, nil
-}
@@ -0,0 +1,95 @@
+package internalrmq
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/transport/queue"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+const (
+	queueName        = "notification"
+	deadExchange     = "notification.dead"
+	deadQueue        = "notification.dead"
+	retryQueuePrefix = "notification.retry."
+
+	// headerRetries counts the retries of a notification, headerError keeps the reason of the last failure.
+	headerRetries = "x-retries"
+	headerError   = "x-error"
+)
+
+// QueueConf bounds the redelivery of the notifications the sender failed to process.
+type QueueConf = queue.Conf
+
+// retryQueue is the delay queue of a delay: its messages expire back to the notification queue.
+// The queue is named by the delay, so a changed config declares new queues instead of conflicting ones.
+func retryQueue(delay time.Duration) string {
+	return retryQueuePrefix + delay.String()
+}
+
+func getQueueDeclated() (string, bool, bool, bool, bool, amqp.Table) {
+	return queueName, false, false, false, false, nil
+}
+
+// declareTopology declares the notification queue, the delay queues of the retries
+// and the dead-letter exchange with its queue.
+func declareTopology(ch *amqp.Channel, conf QueueConf) (amqp.Queue, error) {
+	queue, err := ch.QueueDeclare(getQueueDeclated())
+	if err != nil {
+		return queue, err
+	}
+	for retry := 1; retry <= conf.Limit(); retry++ {
+		delay := conf.Delay(retry)
+		_, err := ch.QueueDeclare(retryQueue(delay), true, false, false, false, amqp.Table{
+			"x-message-ttl":             delay.Milliseconds(),
+			"x-dead-letter-exchange":    "",
+			"x-dead-letter-routing-key": queueName,
+		})
+		if err != nil {
+			return queue, fmt.Errorf("can't declare retry queue:%w", err)
+		}
+	}
+	if err := ch.ExchangeDeclare(deadExchange, amqp.ExchangeFanout, true, false, false, false, nil); err != nil {
+		return queue, fmt.Errorf("can't declare dead-letter exchange:%w", err)
+	}
+	if _, err := ch.QueueDeclare(deadQueue, true, false, false, false, nil); err != nil {
+		return queue, fmt.Errorf("can't declare dead-letter queue:%w", err)
+	}
+	if err := ch.QueueBind(deadQueue, "", deadExchange, false, nil); err != nil {
+		return queue, fmt.Errorf("can't bind dead-letter queue:%w", err)
+	}
+	return queue, nil
+}
+
+// retries returns the retries counted in the headers of a message.
+func retries(headers amqp.Table) int {
+	switch v := headers[headerRetries].(type) {
+	case int32:
+		return int(v)
+	case int64:
+		return int(v)
+	case int:
+		return v
+	case string:
+		n, _ := strconv.Atoi(v)
+		return n
+	}
+	return 0
+}
+
+// withHeaders copies headers with the retries and the error replaced.
+func withHeaders(headers amqp.Table, retries int, reason error) amqp.Table {
+	table := amqp.Table{}
+	for k, v := range headers {
+		table[k] = v
+	}
+	table[headerRetries] = int32(retries)
+	if reason != nil {
+		table[headerError] = reason.Error()
+	} else {
+		delete(table, headerError)
+	}
+	return table
+}
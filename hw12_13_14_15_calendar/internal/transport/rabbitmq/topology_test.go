@@ -0,0 +1,32 @@
+package internalrmq
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryQueue(t *testing.T) {
+	conf := QueueConf{MaxRetries: 3, RetryDelay: time.Second, MaxRetryDelay: 3 * time.Second}
+	require.Equal(t, "notification.retry.3s", retryQueue(conf.Delay(3)))
+}
+
+func TestHeaders(t *testing.T) {
+	require.Zero(t, retries(nil))
+	require.Equal(t, 2, retries(amqp.Table{headerRetries: int32(2)}))
+	require.Equal(t, 3, retries(amqp.Table{headerRetries: int64(3)}))
+
+	headers := amqp.Table{"x-other": "kept", headerError: "old"}
+	retried := withHeaders(headers, 1, errors.New("smtp down"))
+	require.Equal(t, 1, retries(retried))
+	require.Equal(t, "smtp down", retried[headerError])
+	require.Equal(t, "kept", retried["x-other"])
+	require.Equal(t, "old", headers[headerError])
+
+	reset := withHeaders(retried, 0, nil)
+	require.Zero(t, retries(reset))
+	require.NotContains(t, reset, headerError)
+}
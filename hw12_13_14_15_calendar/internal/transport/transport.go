@@ -0,0 +1,115 @@
+// Package transport carries the notifications from the scheduler to the sender through a broker:
+// RabbitMQ or a directory of the host. The scheduler and the sender running in one process,
+// as in the tests, share a memorybroker.Broker instead.
+package transport
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/model"
+	filebroker "github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/transport/file"
+	"github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/transport/queue"
+	internalrmq "github.com/cronnoss/hw-test/hw12_13_14_15_calendar/internal/transport/rabbitmq"
+)
+
+const (
+	BrokerRabbitMQ = "rabbitmq"
+	BrokerFile     = "file"
+	// BrokerMemory only connects the services running in one process, the separate scheduler
+	// and sender refuse it instead of losing the notifications.
+	BrokerMemory = "memory"
+)
+
+// defaultDir keeps the queue of the file broker when the config sets no directory.
+const defaultDir = "./queue"
+
+type Conf struct {
+	// Broker is "rabbitmq" or "file", rabbitmq when empty.
+	Broker string `toml:"broker"`
+	// Dir keeps the queue of the file broker, shared by the scheduler and the sender.
+	Dir string `toml:"dir"`
+}
+
+type Logger interface {
+	Fatalf(format string, a ...interface{})
+	Errorf(format string, a ...interface{})
+	Warningf(format string, a ...interface{})
+	Infof(format string, a ...interface{})
+	Debugf(format string, a ...interface{})
+}
+
+// Publisher publishes the notifications of the scheduler.
+type Publisher interface {
+	Connect(context.Context) error
+	Close(context.Context) error
+	SendNotification(context.Context, *model.NotificationMsg) error
+}
+
+// Subscriber receives the notifications of the sender, they stay with the broker until they are settled.
+type Subscriber interface {
+	Connect(context.Context) error
+	Close(context.Context) error
+	NotifyChannel() <-chan model.NotificationDelivery
+}
+
+// DeadLetters inspects and requeues the notifications without retries left.
+type DeadLetters interface {
+	Connect(context.Context) error
+	Close(context.Context) error
+	List(context.Context) ([]queue.DeadLetter, error)
+	Requeue(context.Context, int) (int, error)
+}
+
+func (c Conf) dir() string {
+	if c.Dir == "" {
+		return defaultDir
+	}
+	return c.Dir
+}
+
+// NewPublisher returns the publisher of the broker of conf, url addresses RabbitMQ.
+func NewPublisher(log Logger, conf Conf, url string) Publisher {
+	switch conf.Broker {
+	case "", BrokerRabbitMQ:
+		return internalrmq.NewProducer(log, url)
+	case BrokerFile:
+		return filebroker.NewProducer(conf.dir())
+	}
+	wrongBroker(conf)
+	return nil
+}
+
+// NewSubscriber returns the subscriber of the broker of conf retrying the notifications by queueConf.
+func NewSubscriber(log Logger, conf Conf, url string, queueConf queue.Conf) Subscriber {
+	switch conf.Broker {
+	case "", BrokerRabbitMQ:
+		return internalrmq.NewConsumer(log, url, queueConf)
+	case BrokerFile:
+		return filebroker.NewConsumer(log, conf.dir(), queueConf)
+	}
+	wrongBroker(conf)
+	return nil
+}
+
+// NewDeadLetters returns the dead letters of the broker of conf.
+func NewDeadLetters(log Logger, conf Conf, url string, queueConf queue.Conf) DeadLetters {
+	switch conf.Broker {
+	case "", BrokerRabbitMQ:
+		return internalrmq.NewDeadLetters(log, url, queueConf)
+	case BrokerFile:
+		return filebroker.NewDeadLetters(conf.dir())
+	}
+	wrongBroker(conf)
+	return nil
+}
+
+func wrongBroker(conf Conf) {
+	if conf.Broker == BrokerMemory {
+		fmt.Fprintln(os.Stderr, "broker memory can't connect the scheduler and the sender processes, use file")
+	} else {
+		fmt.Fprintf(os.Stderr, "wrong broker %q\n", conf.Broker)
+	}
+	os.Exit(1)
+}
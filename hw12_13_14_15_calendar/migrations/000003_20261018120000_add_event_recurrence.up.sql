@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE events ADD COLUMN IF NOT EXISTS rrule        TEXT;
+ALTER TABLE events ADD COLUMN IF NOT EXISTS exdates      TEXT;
+ALTER TABLE events ADD COLUMN IF NOT EXISTS overrides    TEXT;
+ALTER TABLE events ADD COLUMN IF NOT EXISTS lastnotified TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS events_rrule_idx ON events (userid) WHERE rrule IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS events_rrule_idx;
+
+ALTER TABLE events DROP COLUMN IF EXISTS rrule;
+ALTER TABLE events DROP COLUMN IF EXISTS exdates;
+ALTER TABLE events DROP COLUMN IF EXISTS overrides;
+ALTER TABLE events DROP COLUMN IF EXISTS lastnotified;
+-- +goose StatementEnd
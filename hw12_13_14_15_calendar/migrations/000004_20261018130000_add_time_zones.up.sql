@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE events ADD COLUMN IF NOT EXISTS timezone TEXT;
+
+CREATE TABLE IF NOT EXISTS users(
+                                    id               BIGINT PRIMARY KEY,
+                                    timezone         TEXT
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS users;
+
+ALTER TABLE events DROP COLUMN IF EXISTS timezone;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS feed_tokens(
+                                    token            TEXT PRIMARY KEY,
+                                    userid           BIGINT NOT NULL,
+                                    created          TIMESTAMP NOT NULL,
+                                    etag             TEXT,
+                                    modified         TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS feed_tokens_userid_idx ON feed_tokens (userid);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS feed_tokens_userid_idx;
+DROP TABLE IF EXISTS feed_tokens;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS calendars(
+                                    id               BIGSERIAL PRIMARY KEY,
+                                    ownerid          BIGINT NOT NULL,
+                                    name             TEXT NOT NULL,
+                                    description      TEXT
+);
+
+CREATE INDEX IF NOT EXISTS calendars_ownerid_idx ON calendars (ownerid);
+
+CREATE TABLE IF NOT EXISTS calendar_shares(
+                                    calendarid       BIGINT NOT NULL REFERENCES calendars (id) ON DELETE CASCADE,
+                                    userid           BIGINT NOT NULL,
+                                    permission       TEXT NOT NULL,
+                                    PRIMARY KEY (calendarid, userid)
+);
+
+CREATE INDEX IF NOT EXISTS calendar_shares_userid_idx ON calendar_shares (userid);
+
+ALTER TABLE events ADD COLUMN IF NOT EXISTS calendarid BIGINT REFERENCES calendars (id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS events_calendarid_idx ON events (calendarid);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS events_calendarid_idx;
+
+ALTER TABLE events DROP COLUMN IF EXISTS calendarid;
+
+DROP INDEX IF EXISTS calendar_shares_userid_idx;
+DROP TABLE IF EXISTS calendar_shares;
+DROP INDEX IF EXISTS calendars_ownerid_idx;
+DROP TABLE IF EXISTS calendars;
+-- +goose StatementEnd
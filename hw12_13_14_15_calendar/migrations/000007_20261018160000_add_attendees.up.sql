@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS attendees(
+                                    eventid          BIGINT NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+                                    userid           BIGINT NOT NULL,
+                                    status           TEXT NOT NULL DEFAULT 'needs-action',
+                                    PRIMARY KEY (eventid, userid)
+);
+
+CREATE INDEX IF NOT EXISTS attendees_userid_idx ON attendees (userid, status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS attendees_userid_idx;
+DROP TABLE IF EXISTS attendees;
+-- +goose StatementEnd
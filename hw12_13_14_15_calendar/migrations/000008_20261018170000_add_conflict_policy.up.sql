@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE events ADD COLUMN IF NOT EXISTS status TEXT;
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS conflictpolicy TEXT;
+
+ALTER TABLE calendars ADD COLUMN IF NOT EXISTS conflictpolicy TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE calendars DROP COLUMN IF EXISTS conflictpolicy;
+
+ALTER TABLE users DROP COLUMN IF EXISTS conflictpolicy;
+
+ALTER TABLE events DROP COLUMN IF EXISTS status;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE events ADD COLUMN IF NOT EXISTS deletedat TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS events_deletedat_idx ON events (deletedat);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS events_deletedat_idx;
+ALTER TABLE events DROP COLUMN IF EXISTS deletedat;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS deliveries(
+                                    dedupkey         TEXT PRIMARY KEY,
+                                    deliveredat      TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS deliveries_deliveredat_idx ON deliveries (deliveredat);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS deliveries_deliveredat_idx;
+DROP TABLE IF EXISTS deliveries;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+// Package migrations embeds the schema migrations, so the calendar applies them without the goose CLI.
+package migrations
+
+import "embed"
+
+// FS holds the PostgreSQL migrations at its root and the SQLite ones in the sqlite directory.
+// SQLite starts from the whole schema at version 8, a later migration is added to both sets with the same version.
+//
+//go:embed *.sql sqlite/*.sql
+var FS embed.FS
@@ -0,0 +1,80 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS calendars(
+                                    id               INTEGER PRIMARY KEY AUTOINCREMENT,
+                                    ownerid          BIGINT NOT NULL,
+                                    name             TEXT NOT NULL,
+                                    description      TEXT,
+                                    conflictpolicy   TEXT
+);
+
+CREATE INDEX IF NOT EXISTS calendars_ownerid_idx ON calendars (ownerid);
+
+CREATE TABLE IF NOT EXISTS calendar_shares(
+                                    calendarid       BIGINT NOT NULL REFERENCES calendars (id) ON DELETE CASCADE,
+                                    userid           BIGINT NOT NULL,
+                                    permission       TEXT NOT NULL,
+                                    PRIMARY KEY (calendarid, userid)
+);
+
+CREATE INDEX IF NOT EXISTS calendar_shares_userid_idx ON calendar_shares (userid);
+
+CREATE TABLE IF NOT EXISTS events(
+                                    id               INTEGER PRIMARY KEY AUTOINCREMENT,
+                                    title            VARCHAR (150) NOT NULL,
+                                    ontime           TIMESTAMP NOT NULL,
+                                    offtime          TIMESTAMP,
+                                    description      TEXT,
+                                    userid           BIGINT NOT NULL,
+                                    notifytime       TIMESTAMP,
+                                    notified         BOOLEAN DEFAULT false,
+                                    rrule            TEXT,
+                                    exdates          TEXT,
+                                    overrides        TEXT,
+                                    lastnotified     TIMESTAMP,
+                                    timezone         TEXT,
+                                    calendarid       BIGINT REFERENCES calendars (id) ON DELETE CASCADE,
+                                    status           TEXT
+);
+
+CREATE INDEX IF NOT EXISTS events_userid_idx ON events (userid);
+CREATE INDEX IF NOT EXISTS events_ontime_idx ON events (ontime);
+CREATE INDEX IF NOT EXISTS events_notify_idx ON events (ontime, notified);
+CREATE INDEX IF NOT EXISTS events_rrule_idx ON events (userid) WHERE rrule IS NOT NULL;
+CREATE INDEX IF NOT EXISTS events_calendarid_idx ON events (calendarid);
+
+CREATE TABLE IF NOT EXISTS users(
+                                    id               BIGINT PRIMARY KEY,
+                                    timezone         TEXT,
+                                    conflictpolicy   TEXT
+);
+
+CREATE TABLE IF NOT EXISTS feed_tokens(
+                                    token            TEXT PRIMARY KEY,
+                                    userid           BIGINT NOT NULL,
+                                    created          TIMESTAMP NOT NULL,
+                                    etag             TEXT,
+                                    modified         TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS feed_tokens_userid_idx ON feed_tokens (userid);
+
+CREATE TABLE IF NOT EXISTS attendees(
+                                    eventid          BIGINT NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+                                    userid           BIGINT NOT NULL,
+                                    status           TEXT NOT NULL DEFAULT 'needs-action',
+                                    PRIMARY KEY (eventid, userid)
+);
+
+CREATE INDEX IF NOT EXISTS attendees_userid_idx ON attendees (userid, status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS attendees;
+DROP TABLE IF EXISTS feed_tokens;
+DROP TABLE IF EXISTS users;
+DROP TABLE IF EXISTS events;
+DROP TABLE IF EXISTS calendar_shares;
+DROP TABLE IF EXISTS calendars;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS event_audit(
+                                    id               INTEGER PRIMARY KEY AUTOINCREMENT,
+                                    eventid          BIGINT NOT NULL,
+                                    action           TEXT NOT NULL,
+                                    actor            TEXT NOT NULL,
+                                    time             TIMESTAMP NOT NULL,
+                                    before           TEXT,
+                                    after            TEXT
+);
+
+CREATE INDEX IF NOT EXISTS event_audit_eventid_idx ON event_audit (eventid, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS event_audit_eventid_idx;
+DROP TABLE IF EXISTS event_audit;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE events ADD COLUMN enqueued BOOLEAN DEFAULT false;
+
+ALTER TABLE events ADD COLUMN lastenqueued TIMESTAMP;
+
+CREATE TABLE IF NOT EXISTS outbox(
+                                    id               INTEGER PRIMARY KEY AUTOINCREMENT,
+                                    dedupkey         TEXT NOT NULL UNIQUE,
+                                    eventid          BIGINT NOT NULL,
+                                    userid           BIGINT NOT NULL,
+                                    title            TEXT,
+                                    date             TIMESTAMP NOT NULL,
+                                    created          TIMESTAMP NOT NULL,
+                                    sentat           TIMESTAMP,
+                                    deliveredat      TIMESTAMP,
+                                    attempts         INTEGER NOT NULL DEFAULT 0,
+                                    lasterror        TEXT
+);
+
+CREATE INDEX IF NOT EXISTS outbox_sentat_idx ON outbox (sentat, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS outbox_sentat_idx;
+DROP TABLE IF EXISTS outbox;
+
+ALTER TABLE events DROP COLUMN lastenqueued;
+
+ALTER TABLE events DROP COLUMN enqueued;
+-- +goose StatementEnd
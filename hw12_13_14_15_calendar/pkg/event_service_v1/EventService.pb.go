@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -27,13 +28,23 @@ type Event struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID          *int64                 `protobuf:"varint,1,opt,name=ID,proto3,oneof" json:"ID,omitempty"`
-	UserID      *int64                 `protobuf:"varint,2,opt,name=UserID,proto3,oneof" json:"UserID,omitempty"`
-	Title       *string                `protobuf:"bytes,3,opt,name=Title,proto3,oneof" json:"Title,omitempty"`
-	Description *string                `protobuf:"bytes,4,opt,name=Description,proto3,oneof" json:"Description,omitempty"`
-	OnTime      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=OnTime,proto3,oneof" json:"OnTime,omitempty"`
-	OffTime     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=OffTime,proto3,oneof" json:"OffTime,omitempty"`
-	NotifyTime  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=NotifyTime,proto3,oneof" json:"NotifyTime,omitempty"`
+	ID           *int64                   `protobuf:"varint,1,opt,name=ID,proto3,oneof" json:"ID,omitempty"`
+	UserID       *int64                   `protobuf:"varint,2,opt,name=UserID,proto3,oneof" json:"UserID,omitempty"`
+	Title        *string                  `protobuf:"bytes,3,opt,name=Title,proto3,oneof" json:"Title,omitempty"`
+	Description  *string                  `protobuf:"bytes,4,opt,name=Description,proto3,oneof" json:"Description,omitempty"`
+	OnTime       *timestamppb.Timestamp   `protobuf:"bytes,5,opt,name=OnTime,proto3,oneof" json:"OnTime,omitempty"`
+	OffTime      *timestamppb.Timestamp   `protobuf:"bytes,6,opt,name=OffTime,proto3,oneof" json:"OffTime,omitempty"`
+	NotifyTime   *timestamppb.Timestamp   `protobuf:"bytes,7,opt,name=NotifyTime,proto3,oneof" json:"NotifyTime,omitempty"`
+	RRule        *string                  `protobuf:"bytes,8,opt,name=RRule,proto3,oneof" json:"RRule,omitempty"`
+	ExDates      []*timestamppb.Timestamp `protobuf:"bytes,9,rep,name=ExDates,proto3" json:"ExDates,omitempty"`
+	Overrides    []*EventOverride         `protobuf:"bytes,10,rep,name=Overrides,proto3" json:"Overrides,omitempty"`
+	RecurrenceID *timestamppb.Timestamp   `protobuf:"bytes,11,opt,name=RecurrenceID,proto3,oneof" json:"RecurrenceID,omitempty"`
+	TimeZone     *string                  `protobuf:"bytes,12,opt,name=TimeZone,proto3,oneof" json:"TimeZone,omitempty"`
+	CalendarID   *int64                   `protobuf:"varint,13,opt,name=CalendarID,proto3,oneof" json:"CalendarID,omitempty"`
+	Attendees    []*Attendee              `protobuf:"bytes,14,rep,name=Attendees,proto3" json:"Attendees,omitempty"`
+	Status       *string                  `protobuf:"bytes,15,opt,name=Status,proto3,oneof" json:"Status,omitempty"`
+	Version      *int64                   `protobuf:"varint,16,opt,name=Version,proto3,oneof" json:"Version,omitempty"`
+	DeletedAt    *timestamppb.Timestamp   `protobuf:"bytes,17,opt,name=DeletedAt,proto3,oneof" json:"DeletedAt,omitempty"`
 }
 
 func (x *Event) Reset() {
@@ -117,16 +128,89 @@ func (x *Event) GetNotifyTime() *timestamppb.Timestamp {
 	return nil
 }
 
-type ReqByEvent struct {
+func (x *Event) GetRRule() string {
+	if x != nil && x.RRule != nil {
+		return *x.RRule
+	}
+	return ""
+}
+
+func (x *Event) GetExDates() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.ExDates
+	}
+	return nil
+}
+
+func (x *Event) GetOverrides() []*EventOverride {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *Event) GetRecurrenceID() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecurrenceID
+	}
+	return nil
+}
+
+func (x *Event) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+func (x *Event) GetCalendarID() int64 {
+	if x != nil && x.CalendarID != nil {
+		return *x.CalendarID
+	}
+	return 0
+}
+
+func (x *Event) GetAttendees() []*Attendee {
+	if x != nil {
+		return x.Attendees
+	}
+	return nil
+}
+
+func (x *Event) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *Event) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *Event) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type Conflict struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Event *Event `protobuf:"bytes,1,opt,name=event,proto3,oneof" json:"event,omitempty"`
+	EventID *int64                 `protobuf:"varint,1,opt,name=EventID,proto3,oneof" json:"EventID,omitempty"`
+	OnTime  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=OnTime,proto3,oneof" json:"OnTime,omitempty"`
+	OffTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=OffTime,proto3,oneof" json:"OffTime,omitempty"`
+	Status  *string                `protobuf:"bytes,4,opt,name=Status,proto3,oneof" json:"Status,omitempty"`
 }
 
-func (x *ReqByEvent) Reset() {
-	*x = ReqByEvent{}
+func (x *Conflict) Reset() {
+	*x = Conflict{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_EventService_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -134,13 +218,13 @@ func (x *ReqByEvent) Reset() {
 	}
 }
 
-func (x *ReqByEvent) String() string {
+func (x *Conflict) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReqByEvent) ProtoMessage() {}
+func (*Conflict) ProtoMessage() {}
 
-func (x *ReqByEvent) ProtoReflect() protoreflect.Message {
+func (x *Conflict) ProtoReflect() protoreflect.Message {
 	mi := &file_EventService_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -152,28 +236,50 @@ func (x *ReqByEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReqByEvent.ProtoReflect.Descriptor instead.
-func (*ReqByEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use Conflict.ProtoReflect.Descriptor instead.
+func (*Conflict) Descriptor() ([]byte, []int) {
 	return file_EventService_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ReqByEvent) GetEvent() *Event {
+func (x *Conflict) GetEventID() int64 {
+	if x != nil && x.EventID != nil {
+		return *x.EventID
+	}
+	return 0
+}
+
+func (x *Conflict) GetOnTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Event
+		return x.OnTime
 	}
 	return nil
 }
 
-type ReqByID struct {
+func (x *Conflict) GetOffTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OffTime
+	}
+	return nil
+}
+
+func (x *Conflict) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+type Attendee struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID *int64 `protobuf:"varint,1,opt,name=ID,proto3,oneof" json:"ID,omitempty"`
+	UserID *int64  `protobuf:"varint,1,opt,name=UserID,proto3,oneof" json:"UserID,omitempty"`
+	Status *string `protobuf:"bytes,2,opt,name=Status,proto3,oneof" json:"Status,omitempty"`
 }
 
-func (x *ReqByID) Reset() {
-	*x = ReqByID{}
+func (x *Attendee) Reset() {
+	*x = Attendee{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_EventService_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -181,13 +287,13 @@ func (x *ReqByID) Reset() {
 	}
 }
 
-func (x *ReqByID) String() string {
+func (x *Attendee) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReqByID) ProtoMessage() {}
+func (*Attendee) ProtoMessage() {}
 
-func (x *ReqByID) ProtoReflect() protoreflect.Message {
+func (x *Attendee) ProtoReflect() protoreflect.Message {
 	mi := &file_EventService_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -199,28 +305,39 @@ func (x *ReqByID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReqByID.ProtoReflect.Descriptor instead.
-func (*ReqByID) Descriptor() ([]byte, []int) {
+// Deprecated: Use Attendee.ProtoReflect.Descriptor instead.
+func (*Attendee) Descriptor() ([]byte, []int) {
 	return file_EventService_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ReqByID) GetID() int64 {
-	if x != nil && x.ID != nil {
-		return *x.ID
+func (x *Attendee) GetUserID() int64 {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
 	}
 	return 0
 }
 
-type ReqByUser struct {
+func (x *Attendee) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+type EventOverride struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID *int64 `protobuf:"varint,1,opt,name=UserID,proto3,oneof" json:"UserID,omitempty"`
+	RecurrenceID *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=RecurrenceID,proto3,oneof" json:"RecurrenceID,omitempty"`
+	Title        *string                `protobuf:"bytes,2,opt,name=Title,proto3,oneof" json:"Title,omitempty"`
+	Description  *string                `protobuf:"bytes,3,opt,name=Description,proto3,oneof" json:"Description,omitempty"`
+	OnTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=OnTime,proto3,oneof" json:"OnTime,omitempty"`
+	OffTime      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=OffTime,proto3,oneof" json:"OffTime,omitempty"`
 }
 
-func (x *ReqByUser) Reset() {
-	*x = ReqByUser{}
+func (x *EventOverride) Reset() {
+	*x = EventOverride{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_EventService_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -228,13 +345,13 @@ func (x *ReqByUser) Reset() {
 	}
 }
 
-func (x *ReqByUser) String() string {
+func (x *EventOverride) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReqByUser) ProtoMessage() {}
+func (*EventOverride) ProtoMessage() {}
 
-func (x *ReqByUser) ProtoReflect() protoreflect.Message {
+func (x *EventOverride) ProtoReflect() protoreflect.Message {
 	mi := &file_EventService_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -246,29 +363,56 @@ func (x *ReqByUser) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReqByUser.ProtoReflect.Descriptor instead.
-func (*ReqByUser) Descriptor() ([]byte, []int) {
+// Deprecated: Use EventOverride.ProtoReflect.Descriptor instead.
+func (*EventOverride) Descriptor() ([]byte, []int) {
 	return file_EventService_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ReqByUser) GetUserID() int64 {
-	if x != nil && x.UserID != nil {
-		return *x.UserID
+func (x *EventOverride) GetRecurrenceID() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecurrenceID
 	}
-	return 0
+	return nil
 }
 
-type ReqByUserByDate struct {
+func (x *EventOverride) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *EventOverride) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *EventOverride) GetOnTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OnTime
+	}
+	return nil
+}
+
+func (x *EventOverride) GetOffTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OffTime
+	}
+	return nil
+}
+
+type ReqByEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID *int64                 `protobuf:"varint,1,opt,name=UserID,proto3,oneof" json:"UserID,omitempty"`
-	Date   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=Date,proto3,oneof" json:"Date,omitempty"`
+	Event *Event `protobuf:"bytes,1,opt,name=event,proto3,oneof" json:"event,omitempty"`
 }
 
-func (x *ReqByUserByDate) Reset() {
-	*x = ReqByUserByDate{}
+func (x *ReqByEvent) Reset() {
+	*x = ReqByEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_EventService_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -276,13 +420,13 @@ func (x *ReqByUserByDate) Reset() {
 	}
 }
 
-func (x *ReqByUserByDate) String() string {
+func (x *ReqByEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReqByUserByDate) ProtoMessage() {}
+func (*ReqByEvent) ProtoMessage() {}
 
-func (x *ReqByUserByDate) ProtoReflect() protoreflect.Message {
+func (x *ReqByEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_EventService_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -294,35 +438,31 @@ func (x *ReqByUserByDate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReqByUserByDate.ProtoReflect.Descriptor instead.
-func (*ReqByUserByDate) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReqByEvent.ProtoReflect.Descriptor instead.
+func (*ReqByEvent) Descriptor() ([]byte, []int) {
 	return file_EventService_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ReqByUserByDate) GetUserID() int64 {
-	if x != nil && x.UserID != nil {
-		return *x.UserID
-	}
-	return 0
-}
-
-func (x *ReqByUserByDate) GetDate() *timestamppb.Timestamp {
+func (x *ReqByEvent) GetEvent() *Event {
 	if x != nil {
-		return x.Date
+		return x.Event
 	}
 	return nil
 }
 
-type RepID struct {
+// ReqPatchEvent changes the fields of event.ID listed in mask, an empty mask lists the fields set in event.
+// A set Version makes the change conditional on it.
+type ReqPatchEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID *int64 `protobuf:"varint,1,opt,name=ID,proto3,oneof" json:"ID,omitempty"`
+	Event *Event                 `protobuf:"bytes,1,opt,name=event,proto3,oneof" json:"event,omitempty"`
+	Mask  *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=mask,proto3,oneof" json:"mask,omitempty"`
 }
 
-func (x *RepID) Reset() {
-	*x = RepID{}
+func (x *ReqPatchEvent) Reset() {
+	*x = ReqPatchEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_EventService_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -330,13 +470,13 @@ func (x *RepID) Reset() {
 	}
 }
 
-func (x *RepID) String() string {
+func (x *ReqPatchEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepID) ProtoMessage() {}
+func (*ReqPatchEvent) ProtoMessage() {}
 
-func (x *RepID) ProtoReflect() protoreflect.Message {
+func (x *ReqPatchEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_EventService_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -348,28 +488,35 @@ func (x *RepID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepID.ProtoReflect.Descriptor instead.
-func (*RepID) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReqPatchEvent.ProtoReflect.Descriptor instead.
+func (*ReqPatchEvent) Descriptor() ([]byte, []int) {
 	return file_EventService_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *RepID) GetID() int64 {
-	if x != nil && x.ID != nil {
-		return *x.ID
+func (x *ReqPatchEvent) GetEvent() *Event {
+	if x != nil {
+		return x.Event
 	}
-	return 0
+	return nil
 }
 
-type RepEvents struct {
+func (x *ReqPatchEvent) GetMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.Mask
+	}
+	return nil
+}
+
+type ReqByID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Event []*Event `protobuf:"bytes,2,rep,name=event,proto3" json:"event,omitempty"`
+	ID *int64 `protobuf:"varint,1,opt,name=ID,proto3,oneof" json:"ID,omitempty"`
 }
 
-func (x *RepEvents) Reset() {
-	*x = RepEvents{}
+func (x *ReqByID) Reset() {
+	*x = ReqByID{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_EventService_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -377,13 +524,13 @@ func (x *RepEvents) Reset() {
 	}
 }
 
-func (x *RepEvents) String() string {
+func (x *ReqByID) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepEvents) ProtoMessage() {}
+func (*ReqByID) ProtoMessage() {}
 
-func (x *RepEvents) ProtoReflect() protoreflect.Message {
+func (x *ReqByID) ProtoReflect() protoreflect.Message {
 	mi := &file_EventService_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -395,172 +542,2841 @@ func (x *RepEvents) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepEvents.ProtoReflect.Descriptor instead.
-func (*RepEvents) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReqByID.ProtoReflect.Descriptor instead.
+func (*ReqByID) Descriptor() ([]byte, []int) {
 	return file_EventService_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *RepEvents) GetEvent() []*Event {
-	if x != nil {
-		return x.Event
+func (x *ReqByID) GetID() int64 {
+	if x != nil && x.ID != nil {
+		return *x.ID
 	}
-	return nil
+	return 0
 }
 
-var File_EventService_proto protoreflect.FileDescriptor
+type ReqByUser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_EventService_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x82, 0x03, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x13,
-	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x02, 0x49, 0x44,
-	0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01,
-	0x12, 0x19, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x02, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x44,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x03, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88,
-	0x01, 0x01, 0x12, 0x37, 0x0a, 0x06, 0x4f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x04,
-	0x52, 0x06, 0x4f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x07, 0x4f,
-	0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x05, 0x52, 0x07, 0x4f, 0x66, 0x66, 0x54,
-	0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x0a, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
-	0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x06, 0x52, 0x0a, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
-	0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x49, 0x44, 0x42, 0x09,
-	0x0a, 0x07, 0x5f, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x54, 0x69,
-	0x74, 0x6c, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x4f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x0a,
-	0x0a, 0x08, 0x5f, 0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x4e,
-	0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x4a, 0x0a, 0x0a, 0x52, 0x65, 0x71,
-	0x42, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48,
-	0x00, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x25, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x42, 0x79, 0x49, 0x44,
-	0x12, 0x13, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x02,
-	0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x49, 0x44, 0x22, 0x33, 0x0a, 0x09,
-	0x52, 0x65, 0x71, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x06, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x06, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x22, 0x77, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01,
-	0x01, 0x12, 0x33, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x04, 0x44,
-	0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x44, 0x61, 0x74, 0x65, 0x22, 0x23, 0x0a, 0x05, 0x52, 0x65,
-	0x70, 0x49, 0x44, 0x12, 0x13, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48,
-	0x00, 0x52, 0x02, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x49, 0x44, 0x22,
-	0x3a, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x05,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x32, 0xfb, 0x04, 0x0a, 0x0e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x56, 0x31, 0x12, 0x46,
-	0x0a, 0x0b, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x71, 0x42, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x17, 0x2e, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x70, 0x49, 0x44, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x79, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a,
-	0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x71, 0x42, 0x79, 0x49, 0x44, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x48, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49,
-	0x44, 0x12, 0x19, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x79, 0x49, 0x44, 0x1a, 0x1b, 0x2e, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0c, 0x47,
-	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x71, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x6c,
-	0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x44, 0x61, 0x79, 0x12, 0x21, 0x2e, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x71, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x44, 0x61, 0x74, 0x65, 0x1a, 0x1b, 0x2e,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x65, 0x65, 0x6b,
-	0x12, 0x21, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x44,
-	0x61, 0x74, 0x65, 0x1a, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x22, 0x00, 0x12, 0x55, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x21, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x79,
-	0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x44, 0x61, 0x74, 0x65, 0x1a, 0x1b, 0x2e, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x42, 0x15, 0x5a, 0x13, 0x2e, 0x2f, 0x3b,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	UserID *int64 `protobuf:"varint,1,opt,name=UserID,proto3,oneof" json:"UserID,omitempty"`
 }
 
-var (
-	file_EventService_proto_rawDescOnce sync.Once
-	file_EventService_proto_rawDescData = file_EventService_proto_rawDesc
-)
-
-func file_EventService_proto_rawDescGZIP() []byte {
-	file_EventService_proto_rawDescOnce.Do(func() {
-		file_EventService_proto_rawDescData = protoimpl.X.CompressGZIP(file_EventService_proto_rawDescData)
-	})
-	return file_EventService_proto_rawDescData
+func (x *ReqByUser) Reset() {
+	*x = ReqByUser{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var file_EventService_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
-var file_EventService_proto_goTypes = []interface{}{
-	(*Event)(nil),                 // 0: event_service_v1.Event
-	(*ReqByEvent)(nil),            // 1: event_service_v1.ReqByEvent
-	(*ReqByID)(nil),               // 2: event_service_v1.ReqByID
-	(*ReqByUser)(nil),             // 3: event_service_v1.ReqByUser
-	(*ReqByUserByDate)(nil),       // 4: event_service_v1.ReqByUserByDate
-	(*RepID)(nil),                 // 5: event_service_v1.RepID
-	(*RepEvents)(nil),             // 6: event_service_v1.RepEvents
-	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 8: google.protobuf.Empty
+func (x *ReqByUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-var file_EventService_proto_depIdxs = []int32{
-	7,  // 0: event_service_v1.Event.OnTime:type_name -> google.protobuf.Timestamp
-	7,  // 1: event_service_v1.Event.OffTime:type_name -> google.protobuf.Timestamp
-	7,  // 2: event_service_v1.Event.NotifyTime:type_name -> google.protobuf.Timestamp
-	0,  // 3: event_service_v1.ReqByEvent.event:type_name -> event_service_v1.Event
-	7,  // 4: event_service_v1.ReqByUserByDate.Date:type_name -> google.protobuf.Timestamp
-	0,  // 5: event_service_v1.RepEvents.event:type_name -> event_service_v1.Event
-	1,  // 6: event_service_v1.EventServiceV1.InsertEvent:input_type -> event_service_v1.ReqByEvent
-	1,  // 7: event_service_v1.EventServiceV1.UpdateEvent:input_type -> event_service_v1.ReqByEvent
-	2,  // 8: event_service_v1.EventServiceV1.DeleteEvent:input_type -> event_service_v1.ReqByID
-	2,  // 9: event_service_v1.EventServiceV1.GetEventByID:input_type -> event_service_v1.ReqByID
-	3,  // 10: event_service_v1.EventServiceV1.GetAllEvents:input_type -> event_service_v1.ReqByUser
-	4,  // 11: event_service_v1.EventServiceV1.GetAllEventsDay:input_type -> event_service_v1.ReqByUserByDate
-	4,  // 12: event_service_v1.EventServiceV1.GetAllEventsWeek:input_type -> event_service_v1.ReqByUserByDate
-	4,  // 13: event_service_v1.EventServiceV1.GetAllEventsMonth:input_type -> event_service_v1.ReqByUserByDate
-	5,  // 14: event_service_v1.EventServiceV1.InsertEvent:output_type -> event_service_v1.RepID
-	8,  // 15: event_service_v1.EventServiceV1.UpdateEvent:output_type -> google.protobuf.Empty
-	8,  // 16: event_service_v1.EventServiceV1.DeleteEvent:output_type -> google.protobuf.Empty
-	6,  // 17: event_service_v1.EventServiceV1.GetEventByID:output_type -> event_service_v1.RepEvents
-	6,  // 18: event_service_v1.EventServiceV1.GetAllEvents:output_type -> event_service_v1.RepEvents
-	6,  // 19: event_service_v1.EventServiceV1.GetAllEventsDay:output_type -> event_service_v1.RepEvents
-	6,  // 20: event_service_v1.EventServiceV1.GetAllEventsWeek:output_type -> event_service_v1.RepEvents
-	6,  // 21: event_service_v1.EventServiceV1.GetAllEventsMonth:output_type -> event_service_v1.RepEvents
-	14, // [14:22] is the sub-list for method output_type
-	6,  // [6:14] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+
+func (*ReqByUser) ProtoMessage() {}
+
+func (x *ReqByUser) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqByUser.ProtoReflect.Descriptor instead.
+func (*ReqByUser) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReqByUser) GetUserID() int64 {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return 0
+}
+
+// ReqTrashEvent selects a deleted event ID in the trash of its owner UserID.
+type ReqTrashEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID *int64 `protobuf:"varint,1,opt,name=UserID,proto3,oneof" json:"UserID,omitempty"`
+	ID     *int64 `protobuf:"varint,2,opt,name=ID,proto3,oneof" json:"ID,omitempty"`
+}
+
+func (x *ReqTrashEvent) Reset() {
+	*x = ReqTrashEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqTrashEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqTrashEvent) ProtoMessage() {}
+
+func (x *ReqTrashEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqTrashEvent.ProtoReflect.Descriptor instead.
+func (*ReqTrashEvent) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReqTrashEvent) GetUserID() int64 {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return 0
+}
+
+func (x *ReqTrashEvent) GetID() int64 {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return 0
+}
+
+type ReqByUserByDate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   *int64                 `protobuf:"varint,1,opt,name=UserID,proto3,oneof" json:"UserID,omitempty"`
+	Date     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=Date,proto3,oneof" json:"Date,omitempty"`
+	TimeZone *string                `protobuf:"bytes,3,opt,name=TimeZone,proto3,oneof" json:"TimeZone,omitempty"`
+}
+
+func (x *ReqByUserByDate) Reset() {
+	*x = ReqByUserByDate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqByUserByDate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqByUserByDate) ProtoMessage() {}
+
+func (x *ReqByUserByDate) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqByUserByDate.ProtoReflect.Descriptor instead.
+func (*ReqByUserByDate) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReqByUserByDate) GetUserID() int64 {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return 0
+}
+
+func (x *ReqByUserByDate) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *ReqByUserByDate) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID             *int64  `protobuf:"varint,1,opt,name=ID,proto3,oneof" json:"ID,omitempty"`
+	TimeZone       *string `protobuf:"bytes,2,opt,name=TimeZone,proto3,oneof" json:"TimeZone,omitempty"`
+	ConflictPolicy *string `protobuf:"bytes,3,opt,name=ConflictPolicy,proto3,oneof" json:"ConflictPolicy,omitempty"`
+	Email          *string `protobuf:"bytes,4,opt,name=Email,proto3,oneof" json:"Email,omitempty"`
+	Locale         *string `protobuf:"bytes,5,opt,name=Locale,proto3,oneof" json:"Locale,omitempty"`
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *User) GetID() int64 {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return 0
+}
+
+func (x *User) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+func (x *User) GetConflictPolicy() string {
+	if x != nil && x.ConflictPolicy != nil {
+		return *x.ConflictPolicy
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *User) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+type ReqByUserSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User `protobuf:"bytes,1,opt,name=user,proto3,oneof" json:"user,omitempty"`
+}
+
+func (x *ReqByUserSettings) Reset() {
+	*x = ReqByUserSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqByUserSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqByUserSettings) ProtoMessage() {}
+
+func (x *ReqByUserSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqByUserSettings.ProtoReflect.Descriptor instead.
+func (*ReqByUserSettings) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReqByUserSettings) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type RepUser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User `protobuf:"bytes,1,opt,name=user,proto3,oneof" json:"user,omitempty"`
+}
+
+func (x *RepUser) Reset() {
+	*x = RepUser{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepUser) ProtoMessage() {}
+
+func (x *RepUser) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepUser.ProtoReflect.Descriptor instead.
+func (*RepUser) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RepUser) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type RepID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        *int64      `protobuf:"varint,1,opt,name=ID,proto3,oneof" json:"ID,omitempty"`
+	Conflicts []*Conflict `protobuf:"bytes,2,rep,name=Conflicts,proto3" json:"Conflicts,omitempty"`
+	Version   *int64      `protobuf:"varint,3,opt,name=Version,proto3,oneof" json:"Version,omitempty"`
+}
+
+func (x *RepID) Reset() {
+	*x = RepID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepID) ProtoMessage() {}
+
+func (x *RepID) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepID.ProtoReflect.Descriptor instead.
+func (*RepID) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RepID) GetID() int64 {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return 0
+}
+
+func (x *RepID) GetConflicts() []*Conflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *RepID) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type RepConflicts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Conflicts []*Conflict `protobuf:"bytes,1,rep,name=Conflicts,proto3" json:"Conflicts,omitempty"`
+	Version   *int64      `protobuf:"varint,2,opt,name=Version,proto3,oneof" json:"Version,omitempty"`
+}
+
+func (x *RepConflicts) Reset() {
+	*x = RepConflicts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepConflicts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepConflicts) ProtoMessage() {}
+
+func (x *RepConflicts) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepConflicts.ProtoReflect.Descriptor instead.
+func (*RepConflicts) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RepConflicts) GetConflicts() []*Conflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *RepConflicts) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type RepEvents struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event []*Event `protobuf:"bytes,2,rep,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *RepEvents) Reset() {
+	*x = RepEvents{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepEvents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepEvents) ProtoMessage() {}
+
+func (x *RepEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepEvents.ProtoReflect.Descriptor instead.
+func (*RepEvents) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RepEvents) GetEvent() []*Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// EventSnapshot is the state of an event in the audit log, with the notification state.
+type EventSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event        *Event                 `protobuf:"bytes,1,opt,name=event,proto3,oneof" json:"event,omitempty"`
+	Notified     *bool                  `protobuf:"varint,2,opt,name=Notified,proto3,oneof" json:"Notified,omitempty"`
+	LastNotified *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=LastNotified,proto3,oneof" json:"LastNotified,omitempty"`
+}
+
+func (x *EventSnapshot) Reset() {
+	*x = EventSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSnapshot) ProtoMessage() {}
+
+func (x *EventSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventSnapshot.ProtoReflect.Descriptor instead.
+func (*EventSnapshot) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *EventSnapshot) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *EventSnapshot) GetNotified() bool {
+	if x != nil && x.Notified != nil {
+		return *x.Notified
+	}
+	return false
+}
+
+func (x *EventSnapshot) GetLastNotified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastNotified
+	}
+	return nil
+}
+
+// AuditEntry is a change of an event, Before is unset for an insert and After for a delete.
+type AuditEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID      *int64                 `protobuf:"varint,1,opt,name=ID,proto3,oneof" json:"ID,omitempty"`
+	EventID *int64                 `protobuf:"varint,2,opt,name=EventID,proto3,oneof" json:"EventID,omitempty"`
+	Action  *string                `protobuf:"bytes,3,opt,name=Action,proto3,oneof" json:"Action,omitempty"`
+	Actor   *string                `protobuf:"bytes,4,opt,name=Actor,proto3,oneof" json:"Actor,omitempty"`
+	Time    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=Time,proto3,oneof" json:"Time,omitempty"`
+	Before  *EventSnapshot         `protobuf:"bytes,6,opt,name=Before,proto3,oneof" json:"Before,omitempty"`
+	After   *EventSnapshot         `protobuf:"bytes,7,opt,name=After,proto3,oneof" json:"After,omitempty"`
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AuditEntry) GetID() int64 {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetEventID() int64 {
+	if x != nil && x.EventID != nil {
+		return *x.EventID
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil && x.Action != nil {
+		return *x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetActor() string {
+	if x != nil && x.Actor != nil {
+		return *x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetBefore() *EventSnapshot {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetAfter() *EventSnapshot {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type RepAuditEntries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*AuditEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *RepAuditEntries) Reset() {
+	*x = RepAuditEntries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepAuditEntries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepAuditEntries) ProtoMessage() {}
+
+func (x *RepAuditEntries) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepAuditEntries.ProtoReflect.Descriptor instead.
+func (*RepAuditEntries) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RepAuditEntries) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type ReqImport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID *int64 `protobuf:"varint,1,opt,name=UserID,proto3,oneof" json:"UserID,omitempty"`
+	Data   []byte `protobuf:"bytes,2,opt,name=Data,proto3,oneof" json:"Data,omitempty"`
+}
+
+func (x *ReqImport) Reset() {
+	*x = ReqImport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqImport) ProtoMessage() {}
+
+func (x *ReqImport) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqImport.ProtoReflect.Descriptor instead.
+func (*ReqImport) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReqImport) GetUserID() int64 {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return 0
+}
+
+func (x *ReqImport) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ImportItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UID     *string  `protobuf:"bytes,1,opt,name=UID,proto3,oneof" json:"UID,omitempty"`
+	EventID *int64   `protobuf:"varint,2,opt,name=EventID,proto3,oneof" json:"EventID,omitempty"`
+	Skipped []string `protobuf:"bytes,3,rep,name=Skipped,proto3" json:"Skipped,omitempty"`
+	Error   *string  `protobuf:"bytes,4,opt,name=Error,proto3,oneof" json:"Error,omitempty"`
+}
+
+func (x *ImportItem) Reset() {
+	*x = ImportItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportItem) ProtoMessage() {}
+
+func (x *ImportItem) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportItem.ProtoReflect.Descriptor instead.
+func (*ImportItem) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ImportItem) GetUID() string {
+	if x != nil && x.UID != nil {
+		return *x.UID
+	}
+	return ""
+}
+
+func (x *ImportItem) GetEventID() int64 {
+	if x != nil && x.EventID != nil {
+		return *x.EventID
+	}
+	return 0
+}
+
+func (x *ImportItem) GetSkipped() []string {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+func (x *ImportItem) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type RepImport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Imported *int64        `protobuf:"varint,1,opt,name=Imported,proto3,oneof" json:"Imported,omitempty"`
+	Items    []*ImportItem `protobuf:"bytes,2,rep,name=Items,proto3" json:"Items,omitempty"`
+}
+
+func (x *RepImport) Reset() {
+	*x = RepImport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepImport) ProtoMessage() {}
+
+func (x *RepImport) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepImport.ProtoReflect.Descriptor instead.
+func (*RepImport) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RepImport) GetImported() int64 {
+	if x != nil && x.Imported != nil {
+		return *x.Imported
+	}
+	return 0
+}
+
+func (x *RepImport) GetItems() []*ImportItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RepExport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=Data,proto3,oneof" json:"Data,omitempty"`
+}
+
+func (x *RepExport) Reset() {
+	*x = RepExport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepExport) ProtoMessage() {}
+
+func (x *RepExport) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepExport.ProtoReflect.Descriptor instead.
+func (*RepExport) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RepExport) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type FeedToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token   *string                `protobuf:"bytes,1,opt,name=Token,proto3,oneof" json:"Token,omitempty"`
+	UserID  *int64                 `protobuf:"varint,2,opt,name=UserID,proto3,oneof" json:"UserID,omitempty"`
+	Created *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=Created,proto3,oneof" json:"Created,omitempty"`
+}
+
+func (x *FeedToken) Reset() {
+	*x = FeedToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedToken) ProtoMessage() {}
+
+func (x *FeedToken) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedToken.ProtoReflect.Descriptor instead.
+func (*FeedToken) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FeedToken) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
+}
+
+func (x *FeedToken) GetUserID() int64 {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return 0
+}
+
+func (x *FeedToken) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+type ReqFeedToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID *int64  `protobuf:"varint,1,opt,name=UserID,proto3,oneof" json:"UserID,omitempty"`
+	Token  *string `protobuf:"bytes,2,opt,name=Token,proto3,oneof" json:"Token,omitempty"`
+}
+
+func (x *ReqFeedToken) Reset() {
+	*x = ReqFeedToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqFeedToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqFeedToken) ProtoMessage() {}
+
+func (x *ReqFeedToken) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqFeedToken.ProtoReflect.Descriptor instead.
+func (*ReqFeedToken) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReqFeedToken) GetUserID() int64 {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return 0
+}
+
+func (x *ReqFeedToken) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
+}
+
+type RepFeedTokens struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tokens []*FeedToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+}
+
+func (x *RepFeedTokens) Reset() {
+	*x = RepFeedTokens{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepFeedTokens) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepFeedTokens) ProtoMessage() {}
+
+func (x *RepFeedTokens) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepFeedTokens.ProtoReflect.Descriptor instead.
+func (*RepFeedTokens) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RepFeedTokens) GetTokens() []*FeedToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type Calendar struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID             *int64  `protobuf:"varint,1,opt,name=ID,proto3,oneof" json:"ID,omitempty"`
+	OwnerID        *int64  `protobuf:"varint,2,opt,name=OwnerID,proto3,oneof" json:"OwnerID,omitempty"`
+	Name           *string `protobuf:"bytes,3,opt,name=Name,proto3,oneof" json:"Name,omitempty"`
+	Description    *string `protobuf:"bytes,4,opt,name=Description,proto3,oneof" json:"Description,omitempty"`
+	Permission     *string `protobuf:"bytes,5,opt,name=Permission,proto3,oneof" json:"Permission,omitempty"`
+	ConflictPolicy *string `protobuf:"bytes,6,opt,name=ConflictPolicy,proto3,oneof" json:"ConflictPolicy,omitempty"`
+}
+
+func (x *Calendar) Reset() {
+	*x = Calendar{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Calendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Calendar) ProtoMessage() {}
+
+func (x *Calendar) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Calendar.ProtoReflect.Descriptor instead.
+func (*Calendar) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Calendar) GetID() int64 {
+	if x != nil && x.ID != nil {
+		return *x.ID
+	}
+	return 0
+}
+
+func (x *Calendar) GetOwnerID() int64 {
+	if x != nil && x.OwnerID != nil {
+		return *x.OwnerID
+	}
+	return 0
+}
+
+func (x *Calendar) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Calendar) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Calendar) GetPermission() string {
+	if x != nil && x.Permission != nil {
+		return *x.Permission
+	}
+	return ""
+}
+
+func (x *Calendar) GetConflictPolicy() string {
+	if x != nil && x.ConflictPolicy != nil {
+		return *x.ConflictPolicy
+	}
+	return ""
+}
+
+type ReqByCalendar struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Calendar *Calendar `protobuf:"bytes,1,opt,name=calendar,proto3,oneof" json:"calendar,omitempty"`
+}
+
+func (x *ReqByCalendar) Reset() {
+	*x = ReqByCalendar{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqByCalendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqByCalendar) ProtoMessage() {}
+
+func (x *ReqByCalendar) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqByCalendar.ProtoReflect.Descriptor instead.
+func (*ReqByCalendar) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReqByCalendar) GetCalendar() *Calendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+type RepCalendars struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Calendars []*Calendar `protobuf:"bytes,1,rep,name=calendars,proto3" json:"calendars,omitempty"`
+}
+
+func (x *RepCalendars) Reset() {
+	*x = RepCalendars{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepCalendars) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepCalendars) ProtoMessage() {}
+
+func (x *RepCalendars) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepCalendars.ProtoReflect.Descriptor instead.
+func (*RepCalendars) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RepCalendars) GetCalendars() []*Calendar {
+	if x != nil {
+		return x.Calendars
+	}
+	return nil
+}
+
+type CalendarShare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CalendarID *int64  `protobuf:"varint,1,opt,name=CalendarID,proto3,oneof" json:"CalendarID,omitempty"`
+	UserID     *int64  `protobuf:"varint,2,opt,name=UserID,proto3,oneof" json:"UserID,omitempty"`
+	Permission *string `protobuf:"bytes,3,opt,name=Permission,proto3,oneof" json:"Permission,omitempty"`
+}
+
+func (x *CalendarShare) Reset() {
+	*x = CalendarShare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalendarShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarShare) ProtoMessage() {}
+
+func (x *CalendarShare) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarShare.ProtoReflect.Descriptor instead.
+func (*CalendarShare) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CalendarShare) GetCalendarID() int64 {
+	if x != nil && x.CalendarID != nil {
+		return *x.CalendarID
+	}
+	return 0
+}
+
+func (x *CalendarShare) GetUserID() int64 {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return 0
+}
+
+func (x *CalendarShare) GetPermission() string {
+	if x != nil && x.Permission != nil {
+		return *x.Permission
+	}
+	return ""
+}
+
+type ReqByCalendarShare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Share *CalendarShare `protobuf:"bytes,1,opt,name=share,proto3,oneof" json:"share,omitempty"`
+}
+
+func (x *ReqByCalendarShare) Reset() {
+	*x = ReqByCalendarShare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqByCalendarShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqByCalendarShare) ProtoMessage() {}
+
+func (x *ReqByCalendarShare) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqByCalendarShare.ProtoReflect.Descriptor instead.
+func (*ReqByCalendarShare) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReqByCalendarShare) GetShare() *CalendarShare {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+type RepCalendarShares struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shares []*CalendarShare `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (x *RepCalendarShares) Reset() {
+	*x = RepCalendarShares{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepCalendarShares) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepCalendarShares) ProtoMessage() {}
+
+func (x *RepCalendarShares) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepCalendarShares.ProtoReflect.Descriptor instead.
+func (*RepCalendarShares) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RepCalendarShares) GetShares() []*CalendarShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type ReqByCalendarsByRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CalendarIDs []int64                `protobuf:"varint,1,rep,packed,name=CalendarIDs,proto3" json:"CalendarIDs,omitempty"`
+	Begin       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=Begin,proto3,oneof" json:"Begin,omitempty"`
+	End         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=End,proto3,oneof" json:"End,omitempty"`
+}
+
+func (x *ReqByCalendarsByRange) Reset() {
+	*x = ReqByCalendarsByRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqByCalendarsByRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqByCalendarsByRange) ProtoMessage() {}
+
+func (x *ReqByCalendarsByRange) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqByCalendarsByRange.ProtoReflect.Descriptor instead.
+func (*ReqByCalendarsByRange) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ReqByCalendarsByRange) GetCalendarIDs() []int64 {
+	if x != nil {
+		return x.CalendarIDs
+	}
+	return nil
+}
+
+func (x *ReqByCalendarsByRange) GetBegin() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Begin
+	}
+	return nil
+}
+
+func (x *ReqByCalendarsByRange) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+type ReqByAttendee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventID *int64  `protobuf:"varint,1,opt,name=EventID,proto3,oneof" json:"EventID,omitempty"`
+	UserID  *int64  `protobuf:"varint,2,opt,name=UserID,proto3,oneof" json:"UserID,omitempty"`
+	Status  *string `protobuf:"bytes,3,opt,name=Status,proto3,oneof" json:"Status,omitempty"`
+}
+
+func (x *ReqByAttendee) Reset() {
+	*x = ReqByAttendee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqByAttendee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqByAttendee) ProtoMessage() {}
+
+func (x *ReqByAttendee) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqByAttendee.ProtoReflect.Descriptor instead.
+func (*ReqByAttendee) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ReqByAttendee) GetEventID() int64 {
+	if x != nil && x.EventID != nil {
+		return *x.EventID
+	}
+	return 0
+}
+
+func (x *ReqByAttendee) GetUserID() int64 {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return 0
+}
+
+func (x *ReqByAttendee) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+type Invitation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event  *Event  `protobuf:"bytes,1,opt,name=event,proto3,oneof" json:"event,omitempty"`
+	Status *string `protobuf:"bytes,2,opt,name=Status,proto3,oneof" json:"Status,omitempty"`
+}
+
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Invitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invitation) ProtoMessage() {}
+
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Invitation) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *Invitation) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+type RepInvitations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invitations []*Invitation `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+}
+
+func (x *RepInvitations) Reset() {
+	*x = RepInvitations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepInvitations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepInvitations) ProtoMessage() {}
+
+func (x *RepInvitations) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepInvitations.ProtoReflect.Descriptor instead.
+func (*RepInvitations) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RepInvitations) GetInvitations() []*Invitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+type Interval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=Start,proto3,oneof" json:"Start,omitempty"`
+	End   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=End,proto3,oneof" json:"End,omitempty"`
+}
+
+func (x *Interval) Reset() {
+	*x = Interval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Interval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interval) ProtoMessage() {}
+
+func (x *Interval) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interval.ProtoReflect.Descriptor instead.
+func (*Interval) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Interval) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *Interval) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+type ReqFreeBusy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []int64                `protobuf:"varint,1,rep,packed,name=UserIDs,proto3" json:"UserIDs,omitempty"`
+	Begin   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=Begin,proto3,oneof" json:"Begin,omitempty"`
+	End     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=End,proto3,oneof" json:"End,omitempty"`
+}
+
+func (x *ReqFreeBusy) Reset() {
+	*x = ReqFreeBusy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqFreeBusy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqFreeBusy) ProtoMessage() {}
+
+func (x *ReqFreeBusy) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqFreeBusy.ProtoReflect.Descriptor instead.
+func (*ReqFreeBusy) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ReqFreeBusy) GetUserIDs() []int64 {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+func (x *ReqFreeBusy) GetBegin() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Begin
+	}
+	return nil
+}
+
+func (x *ReqFreeBusy) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+type UserBusy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID *int64      `protobuf:"varint,1,opt,name=UserID,proto3,oneof" json:"UserID,omitempty"`
+	Busy   []*Interval `protobuf:"bytes,2,rep,name=Busy,proto3" json:"Busy,omitempty"`
+}
+
+func (x *UserBusy) Reset() {
+	*x = UserBusy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserBusy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserBusy) ProtoMessage() {}
+
+func (x *UserBusy) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserBusy.ProtoReflect.Descriptor instead.
+func (*UserBusy) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UserBusy) GetUserID() int64 {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return 0
+}
+
+func (x *UserBusy) GetBusy() []*Interval {
+	if x != nil {
+		return x.Busy
+	}
+	return nil
+}
+
+type RepFreeBusy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Busy  []*Interval `protobuf:"bytes,1,rep,name=Busy,proto3" json:"Busy,omitempty"`
+	Users []*UserBusy `protobuf:"bytes,2,rep,name=Users,proto3" json:"Users,omitempty"`
+}
+
+func (x *RepFreeBusy) Reset() {
+	*x = RepFreeBusy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepFreeBusy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepFreeBusy) ProtoMessage() {}
+
+func (x *RepFreeBusy) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepFreeBusy.ProtoReflect.Descriptor instead.
+func (*RepFreeBusy) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RepFreeBusy) GetBusy() []*Interval {
+	if x != nil {
+		return x.Busy
+	}
+	return nil
+}
+
+func (x *RepFreeBusy) GetUsers() []*UserBusy {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type ReqSlots struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs   []int64                `protobuf:"varint,1,rep,packed,name=UserIDs,proto3" json:"UserIDs,omitempty"`
+	Minutes   *int64                 `protobuf:"varint,2,opt,name=Minutes,proto3,oneof" json:"Minutes,omitempty"`
+	Begin     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=Begin,proto3,oneof" json:"Begin,omitempty"`
+	End       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=End,proto3,oneof" json:"End,omitempty"`
+	WorkStart *string                `protobuf:"bytes,5,opt,name=WorkStart,proto3,oneof" json:"WorkStart,omitempty"`
+	WorkEnd   *string                `protobuf:"bytes,6,opt,name=WorkEnd,proto3,oneof" json:"WorkEnd,omitempty"`
+	TimeZone  *string                `protobuf:"bytes,7,opt,name=TimeZone,proto3,oneof" json:"TimeZone,omitempty"`
+	Limit     *int64                 `protobuf:"varint,8,opt,name=Limit,proto3,oneof" json:"Limit,omitempty"`
+}
+
+func (x *ReqSlots) Reset() {
+	*x = ReqSlots{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqSlots) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqSlots) ProtoMessage() {}
+
+func (x *ReqSlots) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqSlots.ProtoReflect.Descriptor instead.
+func (*ReqSlots) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ReqSlots) GetUserIDs() []int64 {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+func (x *ReqSlots) GetMinutes() int64 {
+	if x != nil && x.Minutes != nil {
+		return *x.Minutes
+	}
+	return 0
+}
+
+func (x *ReqSlots) GetBegin() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Begin
+	}
+	return nil
+}
+
+func (x *ReqSlots) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *ReqSlots) GetWorkStart() string {
+	if x != nil && x.WorkStart != nil {
+		return *x.WorkStart
+	}
+	return ""
+}
+
+func (x *ReqSlots) GetWorkEnd() string {
+	if x != nil && x.WorkEnd != nil {
+		return *x.WorkEnd
+	}
+	return ""
+}
+
+func (x *ReqSlots) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+func (x *ReqSlots) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type RepIntervals struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Intervals []*Interval `protobuf:"bytes,1,rep,name=intervals,proto3" json:"intervals,omitempty"`
+}
+
+func (x *RepIntervals) Reset() {
+	*x = RepIntervals{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_EventService_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepIntervals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepIntervals) ProtoMessage() {}
+
+func (x *RepIntervals) ProtoReflect() protoreflect.Message {
+	mi := &file_EventService_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepIntervals.ProtoReflect.Descriptor instead.
+func (*RepIntervals) Descriptor() ([]byte, []int) {
+	return file_EventService_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RepIntervals) GetIntervals() []*Interval {
+	if x != nil {
+		return x.Intervals
+	}
+	return nil
+}
+
+var File_EventService_proto protoreflect.FileDescriptor
+
+var file_EventService_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xae, 0x07, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x13, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x02,
+	0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88,
+	0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x02, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a,
+	0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x03, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x06, 0x4f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x04, 0x52, 0x06, 0x4f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a,
+	0x07, 0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x05, 0x52, 0x07, 0x4f, 0x66,
+	0x66, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x0a, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x06, 0x52, 0x0a, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x52, 0x52, 0x75,
+	0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x05, 0x52, 0x52, 0x75, 0x6c,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x07, 0x45, 0x78, 0x44, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x07, 0x45, 0x78, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x09, 0x4f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x09,
+	0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0c, 0x52, 0x65, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x08, 0x52, 0x0c, 0x52,
+	0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x1f,
+	0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x09, 0x52, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x23, 0x0a, 0x0a, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x44, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x0a, 0x52, 0x0a, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49,
+	0x44, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x65,
+	0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x6e,
+	0x64, 0x65, 0x65, 0x52, 0x09, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x73, 0x12, 0x1b,
+	0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0b,
+	0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x48, 0x0c, 0x52, 0x07,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x09, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x0d, 0x52, 0x09, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x49, 0x44,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x54, 0x69, 0x74, 0x6c, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x4f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x52, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x54, 0x69, 0x6d, 0x65, 0x5a,
+	0x6f, 0x6e, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xe8, 0x01, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x06, 0x4f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48,
+	0x01, 0x52, 0x06, 0x4f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x07,
+	0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x07, 0x4f, 0x66, 0x66,
+	0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x4f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x5a, 0x0a, 0x08, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x12, 0x1b,
+	0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00,
+	0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xcc,
+	0x02, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x12, 0x43, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x48, 0x00, 0x52, 0x0c, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x25, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x06, 0x4f, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x48, 0x03, 0x52, 0x06, 0x4f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x39, 0x0a, 0x07, 0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x04, 0x52,
+	0x07, 0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x4f, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x4f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x4a, 0x0a,
+	0x0a, 0x52, 0x65, 0x71, 0x42, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x0d, 0x52, 0x65,
+	0x71, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x33, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x01, 0x52, 0x04, 0x6d, 0x61, 0x73,
+	0x6b, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x22, 0x25, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x42, 0x79,
+	0x49, 0x44, 0x12, 0x13, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00,
+	0x52, 0x02, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x49, 0x44, 0x22, 0x33,
+	0x0a, 0x09, 0x52, 0x65, 0x71, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x22, 0x53, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x54, 0x72, 0x61, 0x73, 0x68, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01,
+	0x01, 0x12, 0x13, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52,
+	0x02, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x49, 0x44, 0x22, 0xa5, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x71,
+	0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x04, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x04, 0x44, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f,
+	0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x02, 0x52, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x44,
+	0x61, 0x74, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65,
+	0x22, 0xdd, 0x01, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x13, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x02, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x1f,
+	0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x2b, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x05, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x06, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x49, 0x44, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x22, 0x4d, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x22,
+	0x43, 0x0a, 0x07, 0x52, 0x65, 0x70, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x48, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x22, 0x88, 0x01, 0x0a, 0x05, 0x52, 0x65, 0x70, 0x49, 0x44, 0x12, 0x13,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x02, 0x49, 0x44,
+	0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x52, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x1d, 0x0a,
+	0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01,
+	0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03,
+	0x5f, 0x49, 0x44, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x73, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12,
+	0x38, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x09,
+	0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x07, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x07, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x22, 0xd1, 0x01, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x08, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x0c, 0x4c, 0x61, 0x73, 0x74, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x0c, 0x4c, 0x61, 0x73,
+	0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x22, 0xed, 0x02, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x13, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x00, 0x52, 0x02, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x07, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x33, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x04, 0x52, 0x04, 0x54, 0x69, 0x6d,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x06, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x05, 0x52, 0x06, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x3a, 0x0a, 0x05, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x48, 0x06, 0x52, 0x05, 0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x05,
+	0x0a, 0x03, 0x5f, 0x49, 0x44, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x54, 0x69, 0x6d, 0x65, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x22, 0x49, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0x55, 0x0a, 0x09, 0x52, 0x65, 0x71, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x44, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x88,
+	0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x44, 0x61, 0x74, 0x61, 0x22, 0x95, 0x01, 0x0a, 0x0a, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x15, 0x0a, 0x03, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x55, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52,
+	0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x53,
+	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x53, 0x6b,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01,
+	0x42, 0x06, 0x0a, 0x04, 0x5f, 0x55, 0x49, 0x44, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x6d,
+	0x0a, 0x09, 0x52, 0x65, 0x70, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52,
+	0x08, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x05,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0x2d, 0x0a,
+	0x09, 0x52, 0x65, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x44, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x44, 0x61, 0x74, 0x61, 0x22, 0x9f, 0x01, 0x0a,
+	0x09, 0x46, 0x65, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x05, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88,
+	0x01, 0x01, 0x12, 0x39, 0x0a, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48,
+	0x02, 0x52, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x5b,
+	0x0a, 0x0c, 0x52, 0x65, 0x71, 0x46, 0x65, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b,
+	0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00,
+	0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x44, 0x0a, 0x0d, 0x52,
+	0x65, 0x70, 0x46, 0x65, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x06,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e,
+	0x46, 0x65, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x22, 0x9e, 0x02, 0x0a, 0x08, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x13,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x02, 0x49, 0x44,
+	0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x07, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x88,
+	0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x02, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x03, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0a, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x05, 0x52, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x49, 0x44, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x4e, 0x61, 0x6d, 0x65,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42,
+	0x11, 0x0a, 0x0f, 0x5f, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x22, 0x59, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x42, 0x79, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x12, 0x3b, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x48, 0x00, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x88, 0x01, 0x01,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x22, 0x48, 0x0a,
+	0x0c, 0x52, 0x65, 0x70, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x12, 0x38, 0x0a,
+	0x09, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x09, 0x63, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x0d, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0a, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52,
+	0x0a, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x1b,
+	0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01,
+	0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x02, 0x52, 0x0a, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x44, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x5a, 0x0a, 0x12, 0x52, 0x65, 0x71,
+	0x42, 0x79, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12,
+	0x3a, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x48,
+	0x00, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x22, 0x4c, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x06, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x42, 0x79, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x42, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x0b, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x44, 0x73, 0x12,
+	0x35, 0x0a, 0x05, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x05, 0x42, 0x65,
+	0x67, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x03, 0x45, 0x6e, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48,
+	0x01, 0x52, 0x03, 0x45, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x42, 0x65,
+	0x67, 0x69, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x45, 0x6e, 0x64, 0x22, 0x8a, 0x01, 0x0a, 0x0d,
+	0x52, 0x65, 0x71, 0x42, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x12, 0x1d, 0x0a,
+	0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00,
+	0x52, 0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x72, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x50, 0x0a, 0x0e,
+	0x52, 0x65, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e,
+	0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x86,
+	0x01, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x35, 0x0a, 0x05, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x31, 0x0a, 0x03, 0x45, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x03, 0x45,
+	0x6e, 0x64, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42,
+	0x06, 0x0a, 0x04, 0x5f, 0x45, 0x6e, 0x64, 0x22, 0xa3, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x46,
+	0x72, 0x65, 0x65, 0x42, 0x75, 0x73, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x73, 0x12, 0x35, 0x0a, 0x05, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x05,
+	0x42, 0x65, 0x67, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x03, 0x45, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x48, 0x01, 0x52, 0x03, 0x45, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x42, 0x65, 0x67, 0x69, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x45, 0x6e, 0x64, 0x22, 0x62, 0x0a,
+	0x08, 0x55, 0x73, 0x65, 0x72, 0x42, 0x75, 0x73, 0x79, 0x12, 0x1b, 0x0a, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x04, 0x42, 0x75, 0x73, 0x79, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x52, 0x04, 0x42, 0x75, 0x73, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x22, 0x6f, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x46, 0x72, 0x65, 0x65, 0x42, 0x75, 0x73, 0x79,
+	0x12, 0x2e, 0x0a, 0x04, 0x42, 0x75, 0x73, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x04, 0x42, 0x75, 0x73, 0x79,
+	0x12, 0x30, 0x0a, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x75, 0x73, 0x79, 0x52, 0x05, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x22, 0xfa, 0x02, 0x0a, 0x08, 0x52, 0x65, 0x71, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x07, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x1d, 0x0a, 0x07, 0x4d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x07, 0x4d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x05, 0x42, 0x65, 0x67, 0x69,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x05, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x31, 0x0a, 0x03, 0x45, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x03, 0x45, 0x6e, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x57, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x09, 0x57, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x57, 0x6f, 0x72, 0x6b, 0x45, 0x6e, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x07, 0x57, 0x6f, 0x72, 0x6b, 0x45, 0x6e,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f,
+	0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x06, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x45, 0x6e, 0x64, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x57, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x57, 0x6f, 0x72, 0x6b, 0x45, 0x6e, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x54, 0x69, 0x6d,
+	0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0x48, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x12,
+	0x38, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x09,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x32, 0x8a, 0x15, 0x0a, 0x0e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x56, 0x31, 0x12, 0x46, 0x0a, 0x0b,
+	0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x71, 0x42, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70,
+	0x49, 0x44, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x79, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x73, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0a, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x1f, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x73, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x79, 0x49, 0x44, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x19, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x42,
+	0x79, 0x49, 0x44, 0x1a, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0x00, 0x12, 0x51, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x19, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x79, 0x49, 0x44,
+	0x1a, 0x21, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x73,
+	0x68, 0x12, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x1b,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x4a, 0x0a,
+	0x0c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x71, 0x54, 0x72, 0x61, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x17,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x70, 0x49, 0x44, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71,
+	0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x44, 0x61, 0x79, 0x12, 0x21, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x42,
+	0x79, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x44, 0x61, 0x74, 0x65, 0x1a, 0x1b, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x65, 0x65, 0x6b, 0x12, 0x21,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x44, 0x61, 0x74,
+	0x65, 0x1a, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00,
+	0x12, 0x55, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x21, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x79, 0x55, 0x73,
+	0x65, 0x72, 0x42, 0x79, 0x44, 0x61, 0x74, 0x65, 0x1a, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x1a,
+	0x19, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x55, 0x73, 0x65, 0x72, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0a,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x71, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0c, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x79, 0x55, 0x73,
+	0x65, 0x72, 0x1a, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x22,
+	0x00, 0x12, 0x51, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x79, 0x55, 0x73, 0x65,
+	0x72, 0x1a, 0x1f, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x46, 0x65, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x79, 0x55, 0x73,
+	0x65, 0x72, 0x1a, 0x1f, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x46, 0x65, 0x65, 0x64, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0f, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x46,
+	0x65, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x46,
+	0x65, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x12, 0x1f, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x79, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x1a, 0x1e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x73, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x1f, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71,
+	0x42, 0x79, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x19, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x79, 0x49,
+	0x44, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x47,
+	0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x19, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x71, 0x42, 0x79, 0x49, 0x44, 0x1a, 0x1e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x73, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x79,
+	0x55, 0x73, 0x65, 0x72, 0x1a, 0x1e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x73, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x24, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x79,
+	0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0f, 0x55, 0x6e, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x24, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x71, 0x42, 0x79, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12,
+	0x19, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x79, 0x49, 0x44, 0x1a, 0x23, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x70, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x22,
+	0x00, 0x12, 0x5c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x79,
+	0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x42, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x1a, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12,
+	0x4b, 0x0a, 0x0e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x65,
+	0x65, 0x12, 0x1f, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64,
+	0x65, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x11,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64,
+	0x65, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x12, 0x1f,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x71, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x20, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x46, 0x72, 0x65, 0x65, 0x42, 0x75, 0x73, 0x79, 0x12, 0x1d, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x71, 0x46, 0x72, 0x65, 0x65, 0x42, 0x75, 0x73, 0x79, 0x1a, 0x1d, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x70, 0x46, 0x72, 0x65, 0x65, 0x42, 0x75, 0x73, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x09, 0x46,
+	0x69, 0x6e, 0x64, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x53,
+	0x6c, 0x6f, 0x74, 0x73, 0x1a, 0x1e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x73, 0x22, 0x00, 0x42, 0x15, 0x5a, 0x13, 0x2e, 0x2f, 0x3b, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_EventService_proto_rawDescOnce sync.Once
+	file_EventService_proto_rawDescData = file_EventService_proto_rawDesc
+)
+
+func file_EventService_proto_rawDescGZIP() []byte {
+	file_EventService_proto_rawDescOnce.Do(func() {
+		file_EventService_proto_rawDescData = protoimpl.X.CompressGZIP(file_EventService_proto_rawDescData)
+	})
+	return file_EventService_proto_rawDescData
+}
+
+var file_EventService_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
+var file_EventService_proto_goTypes = []interface{}{
+	(*Event)(nil),                 // 0: event_service_v1.Event
+	(*Conflict)(nil),              // 1: event_service_v1.Conflict
+	(*Attendee)(nil),              // 2: event_service_v1.Attendee
+	(*EventOverride)(nil),         // 3: event_service_v1.EventOverride
+	(*ReqByEvent)(nil),            // 4: event_service_v1.ReqByEvent
+	(*ReqPatchEvent)(nil),         // 5: event_service_v1.ReqPatchEvent
+	(*ReqByID)(nil),               // 6: event_service_v1.ReqByID
+	(*ReqByUser)(nil),             // 7: event_service_v1.ReqByUser
+	(*ReqTrashEvent)(nil),         // 8: event_service_v1.ReqTrashEvent
+	(*ReqByUserByDate)(nil),       // 9: event_service_v1.ReqByUserByDate
+	(*User)(nil),                  // 10: event_service_v1.User
+	(*ReqByUserSettings)(nil),     // 11: event_service_v1.ReqByUserSettings
+	(*RepUser)(nil),               // 12: event_service_v1.RepUser
+	(*RepID)(nil),                 // 13: event_service_v1.RepID
+	(*RepConflicts)(nil),          // 14: event_service_v1.RepConflicts
+	(*RepEvents)(nil),             // 15: event_service_v1.RepEvents
+	(*EventSnapshot)(nil),         // 16: event_service_v1.EventSnapshot
+	(*AuditEntry)(nil),            // 17: event_service_v1.AuditEntry
+	(*RepAuditEntries)(nil),       // 18: event_service_v1.RepAuditEntries
+	(*ReqImport)(nil),             // 19: event_service_v1.ReqImport
+	(*ImportItem)(nil),            // 20: event_service_v1.ImportItem
+	(*RepImport)(nil),             // 21: event_service_v1.RepImport
+	(*RepExport)(nil),             // 22: event_service_v1.RepExport
+	(*FeedToken)(nil),             // 23: event_service_v1.FeedToken
+	(*ReqFeedToken)(nil),          // 24: event_service_v1.ReqFeedToken
+	(*RepFeedTokens)(nil),         // 25: event_service_v1.RepFeedTokens
+	(*Calendar)(nil),              // 26: event_service_v1.Calendar
+	(*ReqByCalendar)(nil),         // 27: event_service_v1.ReqByCalendar
+	(*RepCalendars)(nil),          // 28: event_service_v1.RepCalendars
+	(*CalendarShare)(nil),         // 29: event_service_v1.CalendarShare
+	(*ReqByCalendarShare)(nil),    // 30: event_service_v1.ReqByCalendarShare
+	(*RepCalendarShares)(nil),     // 31: event_service_v1.RepCalendarShares
+	(*ReqByCalendarsByRange)(nil), // 32: event_service_v1.ReqByCalendarsByRange
+	(*ReqByAttendee)(nil),         // 33: event_service_v1.ReqByAttendee
+	(*Invitation)(nil),            // 34: event_service_v1.Invitation
+	(*RepInvitations)(nil),        // 35: event_service_v1.RepInvitations
+	(*Interval)(nil),              // 36: event_service_v1.Interval
+	(*ReqFreeBusy)(nil),           // 37: event_service_v1.ReqFreeBusy
+	(*UserBusy)(nil),              // 38: event_service_v1.UserBusy
+	(*RepFreeBusy)(nil),           // 39: event_service_v1.RepFreeBusy
+	(*ReqSlots)(nil),              // 40: event_service_v1.ReqSlots
+	(*RepIntervals)(nil),          // 41: event_service_v1.RepIntervals
+	(*timestamppb.Timestamp)(nil), // 42: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil), // 43: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 44: google.protobuf.Empty
+}
+var file_EventService_proto_depIdxs = []int32{
+	42, // 0: event_service_v1.Event.OnTime:type_name -> google.protobuf.Timestamp
+	42, // 1: event_service_v1.Event.OffTime:type_name -> google.protobuf.Timestamp
+	42, // 2: event_service_v1.Event.NotifyTime:type_name -> google.protobuf.Timestamp
+	42, // 3: event_service_v1.Event.ExDates:type_name -> google.protobuf.Timestamp
+	3,  // 4: event_service_v1.Event.Overrides:type_name -> event_service_v1.EventOverride
+	42, // 5: event_service_v1.Event.RecurrenceID:type_name -> google.protobuf.Timestamp
+	2,  // 6: event_service_v1.Event.Attendees:type_name -> event_service_v1.Attendee
+	42, // 7: event_service_v1.Event.DeletedAt:type_name -> google.protobuf.Timestamp
+	42, // 8: event_service_v1.Conflict.OnTime:type_name -> google.protobuf.Timestamp
+	42, // 9: event_service_v1.Conflict.OffTime:type_name -> google.protobuf.Timestamp
+	42, // 10: event_service_v1.EventOverride.RecurrenceID:type_name -> google.protobuf.Timestamp
+	42, // 11: event_service_v1.EventOverride.OnTime:type_name -> google.protobuf.Timestamp
+	42, // 12: event_service_v1.EventOverride.OffTime:type_name -> google.protobuf.Timestamp
+	0,  // 13: event_service_v1.ReqByEvent.event:type_name -> event_service_v1.Event
+	0,  // 14: event_service_v1.ReqPatchEvent.event:type_name -> event_service_v1.Event
+	43, // 15: event_service_v1.ReqPatchEvent.mask:type_name -> google.protobuf.FieldMask
+	42, // 16: event_service_v1.ReqByUserByDate.Date:type_name -> google.protobuf.Timestamp
+	10, // 17: event_service_v1.ReqByUserSettings.user:type_name -> event_service_v1.User
+	10, // 18: event_service_v1.RepUser.user:type_name -> event_service_v1.User
+	1,  // 19: event_service_v1.RepID.Conflicts:type_name -> event_service_v1.Conflict
+	1,  // 20: event_service_v1.RepConflicts.Conflicts:type_name -> event_service_v1.Conflict
+	0,  // 21: event_service_v1.RepEvents.event:type_name -> event_service_v1.Event
+	0,  // 22: event_service_v1.EventSnapshot.event:type_name -> event_service_v1.Event
+	42, // 23: event_service_v1.EventSnapshot.LastNotified:type_name -> google.protobuf.Timestamp
+	42, // 24: event_service_v1.AuditEntry.Time:type_name -> google.protobuf.Timestamp
+	16, // 25: event_service_v1.AuditEntry.Before:type_name -> event_service_v1.EventSnapshot
+	16, // 26: event_service_v1.AuditEntry.After:type_name -> event_service_v1.EventSnapshot
+	17, // 27: event_service_v1.RepAuditEntries.entries:type_name -> event_service_v1.AuditEntry
+	20, // 28: event_service_v1.RepImport.Items:type_name -> event_service_v1.ImportItem
+	42, // 29: event_service_v1.FeedToken.Created:type_name -> google.protobuf.Timestamp
+	23, // 30: event_service_v1.RepFeedTokens.tokens:type_name -> event_service_v1.FeedToken
+	26, // 31: event_service_v1.ReqByCalendar.calendar:type_name -> event_service_v1.Calendar
+	26, // 32: event_service_v1.RepCalendars.calendars:type_name -> event_service_v1.Calendar
+	29, // 33: event_service_v1.ReqByCalendarShare.share:type_name -> event_service_v1.CalendarShare
+	29, // 34: event_service_v1.RepCalendarShares.shares:type_name -> event_service_v1.CalendarShare
+	42, // 35: event_service_v1.ReqByCalendarsByRange.Begin:type_name -> google.protobuf.Timestamp
+	42, // 36: event_service_v1.ReqByCalendarsByRange.End:type_name -> google.protobuf.Timestamp
+	0,  // 37: event_service_v1.Invitation.event:type_name -> event_service_v1.Event
+	34, // 38: event_service_v1.RepInvitations.invitations:type_name -> event_service_v1.Invitation
+	42, // 39: event_service_v1.Interval.Start:type_name -> google.protobuf.Timestamp
+	42, // 40: event_service_v1.Interval.End:type_name -> google.protobuf.Timestamp
+	42, // 41: event_service_v1.ReqFreeBusy.Begin:type_name -> google.protobuf.Timestamp
+	42, // 42: event_service_v1.ReqFreeBusy.End:type_name -> google.protobuf.Timestamp
+	36, // 43: event_service_v1.UserBusy.Busy:type_name -> event_service_v1.Interval
+	36, // 44: event_service_v1.RepFreeBusy.Busy:type_name -> event_service_v1.Interval
+	38, // 45: event_service_v1.RepFreeBusy.Users:type_name -> event_service_v1.UserBusy
+	42, // 46: event_service_v1.ReqSlots.Begin:type_name -> google.protobuf.Timestamp
+	42, // 47: event_service_v1.ReqSlots.End:type_name -> google.protobuf.Timestamp
+	36, // 48: event_service_v1.RepIntervals.intervals:type_name -> event_service_v1.Interval
+	4,  // 49: event_service_v1.EventServiceV1.InsertEvent:input_type -> event_service_v1.ReqByEvent
+	4,  // 50: event_service_v1.EventServiceV1.UpdateEvent:input_type -> event_service_v1.ReqByEvent
+	5,  // 51: event_service_v1.EventServiceV1.PatchEvent:input_type -> event_service_v1.ReqPatchEvent
+	6,  // 52: event_service_v1.EventServiceV1.DeleteEvent:input_type -> event_service_v1.ReqByID
+	6,  // 53: event_service_v1.EventServiceV1.GetEventByID:input_type -> event_service_v1.ReqByID
+	6,  // 54: event_service_v1.EventServiceV1.GetEventHistory:input_type -> event_service_v1.ReqByID
+	7,  // 55: event_service_v1.EventServiceV1.GetTrash:input_type -> event_service_v1.ReqByUser
+	8,  // 56: event_service_v1.EventServiceV1.RestoreEvent:input_type -> event_service_v1.ReqTrashEvent
+	7,  // 57: event_service_v1.EventServiceV1.GetAllEvents:input_type -> event_service_v1.ReqByUser
+	9,  // 58: event_service_v1.EventServiceV1.GetAllEventsDay:input_type -> event_service_v1.ReqByUserByDate
+	9,  // 59: event_service_v1.EventServiceV1.GetAllEventsWeek:input_type -> event_service_v1.ReqByUserByDate
+	9,  // 60: event_service_v1.EventServiceV1.GetAllEventsMonth:input_type -> event_service_v1.ReqByUserByDate
+	7,  // 61: event_service_v1.EventServiceV1.GetUser:input_type -> event_service_v1.ReqByUser
+	11, // 62: event_service_v1.EventServiceV1.UpdateUser:input_type -> event_service_v1.ReqByUserSettings
+	19, // 63: event_service_v1.EventServiceV1.ImportEvents:input_type -> event_service_v1.ReqImport
+	7,  // 64: event_service_v1.EventServiceV1.ExportEvents:input_type -> event_service_v1.ReqByUser
+	7,  // 65: event_service_v1.EventServiceV1.CreateFeedToken:input_type -> event_service_v1.ReqByUser
+	7,  // 66: event_service_v1.EventServiceV1.GetFeedTokens:input_type -> event_service_v1.ReqByUser
+	24, // 67: event_service_v1.EventServiceV1.RevokeFeedToken:input_type -> event_service_v1.ReqFeedToken
+	27, // 68: event_service_v1.EventServiceV1.CreateCalendar:input_type -> event_service_v1.ReqByCalendar
+	27, // 69: event_service_v1.EventServiceV1.UpdateCalendar:input_type -> event_service_v1.ReqByCalendar
+	6,  // 70: event_service_v1.EventServiceV1.DeleteCalendar:input_type -> event_service_v1.ReqByID
+	6,  // 71: event_service_v1.EventServiceV1.GetCalendar:input_type -> event_service_v1.ReqByID
+	7,  // 72: event_service_v1.EventServiceV1.GetCalendars:input_type -> event_service_v1.ReqByUser
+	30, // 73: event_service_v1.EventServiceV1.ShareCalendar:input_type -> event_service_v1.ReqByCalendarShare
+	30, // 74: event_service_v1.EventServiceV1.UnshareCalendar:input_type -> event_service_v1.ReqByCalendarShare
+	6,  // 75: event_service_v1.EventServiceV1.GetCalendarShares:input_type -> event_service_v1.ReqByID
+	32, // 76: event_service_v1.EventServiceV1.GetCalendarsEvents:input_type -> event_service_v1.ReqByCalendarsByRange
+	33, // 77: event_service_v1.EventServiceV1.InviteAttendee:input_type -> event_service_v1.ReqByAttendee
+	33, // 78: event_service_v1.EventServiceV1.RespondInvitation:input_type -> event_service_v1.ReqByAttendee
+	33, // 79: event_service_v1.EventServiceV1.RemoveAttendee:input_type -> event_service_v1.ReqByAttendee
+	7,  // 80: event_service_v1.EventServiceV1.GetInvitations:input_type -> event_service_v1.ReqByUser
+	37, // 81: event_service_v1.EventServiceV1.GetFreeBusy:input_type -> event_service_v1.ReqFreeBusy
+	40, // 82: event_service_v1.EventServiceV1.FindSlots:input_type -> event_service_v1.ReqSlots
+	13, // 83: event_service_v1.EventServiceV1.InsertEvent:output_type -> event_service_v1.RepID
+	14, // 84: event_service_v1.EventServiceV1.UpdateEvent:output_type -> event_service_v1.RepConflicts
+	14, // 85: event_service_v1.EventServiceV1.PatchEvent:output_type -> event_service_v1.RepConflicts
+	44, // 86: event_service_v1.EventServiceV1.DeleteEvent:output_type -> google.protobuf.Empty
+	15, // 87: event_service_v1.EventServiceV1.GetEventByID:output_type -> event_service_v1.RepEvents
+	18, // 88: event_service_v1.EventServiceV1.GetEventHistory:output_type -> event_service_v1.RepAuditEntries
+	15, // 89: event_service_v1.EventServiceV1.GetTrash:output_type -> event_service_v1.RepEvents
+	13, // 90: event_service_v1.EventServiceV1.RestoreEvent:output_type -> event_service_v1.RepID
+	15, // 91: event_service_v1.EventServiceV1.GetAllEvents:output_type -> event_service_v1.RepEvents
+	15, // 92: event_service_v1.EventServiceV1.GetAllEventsDay:output_type -> event_service_v1.RepEvents
+	15, // 93: event_service_v1.EventServiceV1.GetAllEventsWeek:output_type -> event_service_v1.RepEvents
+	15, // 94: event_service_v1.EventServiceV1.GetAllEventsMonth:output_type -> event_service_v1.RepEvents
+	12, // 95: event_service_v1.EventServiceV1.GetUser:output_type -> event_service_v1.RepUser
+	44, // 96: event_service_v1.EventServiceV1.UpdateUser:output_type -> google.protobuf.Empty
+	21, // 97: event_service_v1.EventServiceV1.ImportEvents:output_type -> event_service_v1.RepImport
+	22, // 98: event_service_v1.EventServiceV1.ExportEvents:output_type -> event_service_v1.RepExport
+	25, // 99: event_service_v1.EventServiceV1.CreateFeedToken:output_type -> event_service_v1.RepFeedTokens
+	25, // 100: event_service_v1.EventServiceV1.GetFeedTokens:output_type -> event_service_v1.RepFeedTokens
+	44, // 101: event_service_v1.EventServiceV1.RevokeFeedToken:output_type -> google.protobuf.Empty
+	28, // 102: event_service_v1.EventServiceV1.CreateCalendar:output_type -> event_service_v1.RepCalendars
+	44, // 103: event_service_v1.EventServiceV1.UpdateCalendar:output_type -> google.protobuf.Empty
+	44, // 104: event_service_v1.EventServiceV1.DeleteCalendar:output_type -> google.protobuf.Empty
+	28, // 105: event_service_v1.EventServiceV1.GetCalendar:output_type -> event_service_v1.RepCalendars
+	28, // 106: event_service_v1.EventServiceV1.GetCalendars:output_type -> event_service_v1.RepCalendars
+	44, // 107: event_service_v1.EventServiceV1.ShareCalendar:output_type -> google.protobuf.Empty
+	44, // 108: event_service_v1.EventServiceV1.UnshareCalendar:output_type -> google.protobuf.Empty
+	31, // 109: event_service_v1.EventServiceV1.GetCalendarShares:output_type -> event_service_v1.RepCalendarShares
+	15, // 110: event_service_v1.EventServiceV1.GetCalendarsEvents:output_type -> event_service_v1.RepEvents
+	44, // 111: event_service_v1.EventServiceV1.InviteAttendee:output_type -> google.protobuf.Empty
+	44, // 112: event_service_v1.EventServiceV1.RespondInvitation:output_type -> google.protobuf.Empty
+	44, // 113: event_service_v1.EventServiceV1.RemoveAttendee:output_type -> google.protobuf.Empty
+	35, // 114: event_service_v1.EventServiceV1.GetInvitations:output_type -> event_service_v1.RepInvitations
+	39, // 115: event_service_v1.EventServiceV1.GetFreeBusy:output_type -> event_service_v1.RepFreeBusy
+	41, // 116: event_service_v1.EventServiceV1.FindSlots:output_type -> event_service_v1.RepIntervals
+	83, // [83:117] is the sub-list for method output_type
+	49, // [49:83] is the sub-list for method input_type
+	49, // [49:49] is the sub-list for extension type_name
+	49, // [49:49] is the sub-list for extension extendee
+	0,  // [0:49] is the sub-list for field type_name
 }
 
 func init() { file_EventService_proto_init() }
@@ -581,8 +3397,140 @@ func file_EventService_proto_init() {
 				return nil
 			}
 		}
-		file_EventService_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqByEvent); i {
+		file_EventService_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Conflict); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Attendee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventOverride); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqByEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPatchEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqByID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqByUser); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqTrashEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqByUserByDate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqByUserSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepUser); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -593,8 +3541,8 @@ func file_EventService_proto_init() {
 				return nil
 			}
 		}
-		file_EventService_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqByID); i {
+		file_EventService_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -605,8 +3553,8 @@ func file_EventService_proto_init() {
 				return nil
 			}
 		}
-		file_EventService_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqByUser); i {
+		file_EventService_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepConflicts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -617,8 +3565,8 @@ func file_EventService_proto_init() {
 				return nil
 			}
 		}
-		file_EventService_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqByUserByDate); i {
+		file_EventService_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepEvents); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -629,8 +3577,8 @@ func file_EventService_proto_init() {
 				return nil
 			}
 		}
-		file_EventService_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepID); i {
+		file_EventService_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventSnapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -641,8 +3589,296 @@ func file_EventService_proto_init() {
 				return nil
 			}
 		}
-		file_EventService_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepEvents); i {
+		file_EventService_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepAuditEntries); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqImport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepImport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepExport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeedToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqFeedToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepFeedTokens); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Calendar); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqByCalendar); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepCalendars); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalendarShare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqByCalendarShare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepCalendarShares); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqByCalendarsByRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqByAttendee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Invitation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepInvitations); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqFreeBusy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserBusy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepFreeBusy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqSlots); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_EventService_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepIntervals); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -660,13 +3896,41 @@ func file_EventService_proto_init() {
 	file_EventService_proto_msgTypes[3].OneofWrappers = []interface{}{}
 	file_EventService_proto_msgTypes[4].OneofWrappers = []interface{}{}
 	file_EventService_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[21].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[22].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[23].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[24].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[26].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[27].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[29].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[30].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[32].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[33].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[34].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[36].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[37].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[38].OneofWrappers = []interface{}{}
+	file_EventService_proto_msgTypes[40].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_EventService_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
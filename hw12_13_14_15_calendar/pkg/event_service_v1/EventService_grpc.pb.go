@@ -20,14 +20,40 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	EventServiceV1_InsertEvent_FullMethodName       = "/event_service_v1.EventServiceV1/InsertEvent"
-	EventServiceV1_UpdateEvent_FullMethodName       = "/event_service_v1.EventServiceV1/UpdateEvent"
-	EventServiceV1_DeleteEvent_FullMethodName       = "/event_service_v1.EventServiceV1/DeleteEvent"
-	EventServiceV1_GetEventByID_FullMethodName      = "/event_service_v1.EventServiceV1/GetEventByID"
-	EventServiceV1_GetAllEvents_FullMethodName      = "/event_service_v1.EventServiceV1/GetAllEvents"
-	EventServiceV1_GetAllEventsDay_FullMethodName   = "/event_service_v1.EventServiceV1/GetAllEventsDay"
-	EventServiceV1_GetAllEventsWeek_FullMethodName  = "/event_service_v1.EventServiceV1/GetAllEventsWeek"
-	EventServiceV1_GetAllEventsMonth_FullMethodName = "/event_service_v1.EventServiceV1/GetAllEventsMonth"
+	EventServiceV1_InsertEvent_FullMethodName        = "/event_service_v1.EventServiceV1/InsertEvent"
+	EventServiceV1_UpdateEvent_FullMethodName        = "/event_service_v1.EventServiceV1/UpdateEvent"
+	EventServiceV1_PatchEvent_FullMethodName         = "/event_service_v1.EventServiceV1/PatchEvent"
+	EventServiceV1_DeleteEvent_FullMethodName        = "/event_service_v1.EventServiceV1/DeleteEvent"
+	EventServiceV1_GetEventByID_FullMethodName       = "/event_service_v1.EventServiceV1/GetEventByID"
+	EventServiceV1_GetEventHistory_FullMethodName    = "/event_service_v1.EventServiceV1/GetEventHistory"
+	EventServiceV1_GetTrash_FullMethodName           = "/event_service_v1.EventServiceV1/GetTrash"
+	EventServiceV1_RestoreEvent_FullMethodName       = "/event_service_v1.EventServiceV1/RestoreEvent"
+	EventServiceV1_GetAllEvents_FullMethodName       = "/event_service_v1.EventServiceV1/GetAllEvents"
+	EventServiceV1_GetAllEventsDay_FullMethodName    = "/event_service_v1.EventServiceV1/GetAllEventsDay"
+	EventServiceV1_GetAllEventsWeek_FullMethodName   = "/event_service_v1.EventServiceV1/GetAllEventsWeek"
+	EventServiceV1_GetAllEventsMonth_FullMethodName  = "/event_service_v1.EventServiceV1/GetAllEventsMonth"
+	EventServiceV1_GetUser_FullMethodName            = "/event_service_v1.EventServiceV1/GetUser"
+	EventServiceV1_UpdateUser_FullMethodName         = "/event_service_v1.EventServiceV1/UpdateUser"
+	EventServiceV1_ImportEvents_FullMethodName       = "/event_service_v1.EventServiceV1/ImportEvents"
+	EventServiceV1_ExportEvents_FullMethodName       = "/event_service_v1.EventServiceV1/ExportEvents"
+	EventServiceV1_CreateFeedToken_FullMethodName    = "/event_service_v1.EventServiceV1/CreateFeedToken"
+	EventServiceV1_GetFeedTokens_FullMethodName      = "/event_service_v1.EventServiceV1/GetFeedTokens"
+	EventServiceV1_RevokeFeedToken_FullMethodName    = "/event_service_v1.EventServiceV1/RevokeFeedToken"
+	EventServiceV1_CreateCalendar_FullMethodName     = "/event_service_v1.EventServiceV1/CreateCalendar"
+	EventServiceV1_UpdateCalendar_FullMethodName     = "/event_service_v1.EventServiceV1/UpdateCalendar"
+	EventServiceV1_DeleteCalendar_FullMethodName     = "/event_service_v1.EventServiceV1/DeleteCalendar"
+	EventServiceV1_GetCalendar_FullMethodName        = "/event_service_v1.EventServiceV1/GetCalendar"
+	EventServiceV1_GetCalendars_FullMethodName       = "/event_service_v1.EventServiceV1/GetCalendars"
+	EventServiceV1_ShareCalendar_FullMethodName      = "/event_service_v1.EventServiceV1/ShareCalendar"
+	EventServiceV1_UnshareCalendar_FullMethodName    = "/event_service_v1.EventServiceV1/UnshareCalendar"
+	EventServiceV1_GetCalendarShares_FullMethodName  = "/event_service_v1.EventServiceV1/GetCalendarShares"
+	EventServiceV1_GetCalendarsEvents_FullMethodName = "/event_service_v1.EventServiceV1/GetCalendarsEvents"
+	EventServiceV1_InviteAttendee_FullMethodName     = "/event_service_v1.EventServiceV1/InviteAttendee"
+	EventServiceV1_RespondInvitation_FullMethodName  = "/event_service_v1.EventServiceV1/RespondInvitation"
+	EventServiceV1_RemoveAttendee_FullMethodName     = "/event_service_v1.EventServiceV1/RemoveAttendee"
+	EventServiceV1_GetInvitations_FullMethodName     = "/event_service_v1.EventServiceV1/GetInvitations"
+	EventServiceV1_GetFreeBusy_FullMethodName        = "/event_service_v1.EventServiceV1/GetFreeBusy"
+	EventServiceV1_FindSlots_FullMethodName          = "/event_service_v1.EventServiceV1/FindSlots"
 )
 
 // EventServiceV1Client is the client API for EventServiceV1 service.
@@ -35,13 +61,39 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type EventServiceV1Client interface {
 	InsertEvent(ctx context.Context, in *ReqByEvent, opts ...grpc.CallOption) (*RepID, error)
-	UpdateEvent(ctx context.Context, in *ReqByEvent, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateEvent(ctx context.Context, in *ReqByEvent, opts ...grpc.CallOption) (*RepConflicts, error)
+	PatchEvent(ctx context.Context, in *ReqPatchEvent, opts ...grpc.CallOption) (*RepConflicts, error)
 	DeleteEvent(ctx context.Context, in *ReqByID, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetEventByID(ctx context.Context, in *ReqByID, opts ...grpc.CallOption) (*RepEvents, error)
+	GetEventHistory(ctx context.Context, in *ReqByID, opts ...grpc.CallOption) (*RepAuditEntries, error)
+	GetTrash(ctx context.Context, in *ReqByUser, opts ...grpc.CallOption) (*RepEvents, error)
+	RestoreEvent(ctx context.Context, in *ReqTrashEvent, opts ...grpc.CallOption) (*RepID, error)
 	GetAllEvents(ctx context.Context, in *ReqByUser, opts ...grpc.CallOption) (*RepEvents, error)
 	GetAllEventsDay(ctx context.Context, in *ReqByUserByDate, opts ...grpc.CallOption) (*RepEvents, error)
 	GetAllEventsWeek(ctx context.Context, in *ReqByUserByDate, opts ...grpc.CallOption) (*RepEvents, error)
 	GetAllEventsMonth(ctx context.Context, in *ReqByUserByDate, opts ...grpc.CallOption) (*RepEvents, error)
+	GetUser(ctx context.Context, in *ReqByUser, opts ...grpc.CallOption) (*RepUser, error)
+	UpdateUser(ctx context.Context, in *ReqByUserSettings, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ImportEvents(ctx context.Context, in *ReqImport, opts ...grpc.CallOption) (*RepImport, error)
+	ExportEvents(ctx context.Context, in *ReqByUser, opts ...grpc.CallOption) (*RepExport, error)
+	CreateFeedToken(ctx context.Context, in *ReqByUser, opts ...grpc.CallOption) (*RepFeedTokens, error)
+	GetFeedTokens(ctx context.Context, in *ReqByUser, opts ...grpc.CallOption) (*RepFeedTokens, error)
+	RevokeFeedToken(ctx context.Context, in *ReqFeedToken, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateCalendar(ctx context.Context, in *ReqByCalendar, opts ...grpc.CallOption) (*RepCalendars, error)
+	UpdateCalendar(ctx context.Context, in *ReqByCalendar, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteCalendar(ctx context.Context, in *ReqByID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetCalendar(ctx context.Context, in *ReqByID, opts ...grpc.CallOption) (*RepCalendars, error)
+	GetCalendars(ctx context.Context, in *ReqByUser, opts ...grpc.CallOption) (*RepCalendars, error)
+	ShareCalendar(ctx context.Context, in *ReqByCalendarShare, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnshareCalendar(ctx context.Context, in *ReqByCalendarShare, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetCalendarShares(ctx context.Context, in *ReqByID, opts ...grpc.CallOption) (*RepCalendarShares, error)
+	GetCalendarsEvents(ctx context.Context, in *ReqByCalendarsByRange, opts ...grpc.CallOption) (*RepEvents, error)
+	InviteAttendee(ctx context.Context, in *ReqByAttendee, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RespondInvitation(ctx context.Context, in *ReqByAttendee, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveAttendee(ctx context.Context, in *ReqByAttendee, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetInvitations(ctx context.Context, in *ReqByUser, opts ...grpc.CallOption) (*RepInvitations, error)
+	GetFreeBusy(ctx context.Context, in *ReqFreeBusy, opts ...grpc.CallOption) (*RepFreeBusy, error)
+	FindSlots(ctx context.Context, in *ReqSlots, opts ...grpc.CallOption) (*RepIntervals, error)
 }
 
 type eventServiceV1Client struct {
@@ -61,8 +113,8 @@ func (c *eventServiceV1Client) InsertEvent(ctx context.Context, in *ReqByEvent,
 	return out, nil
 }
 
-func (c *eventServiceV1Client) UpdateEvent(ctx context.Context, in *ReqByEvent, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *eventServiceV1Client) UpdateEvent(ctx context.Context, in *ReqByEvent, opts ...grpc.CallOption) (*RepConflicts, error) {
+	out := new(RepConflicts)
 	err := c.cc.Invoke(ctx, EventServiceV1_UpdateEvent_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -70,6 +122,15 @@ func (c *eventServiceV1Client) UpdateEvent(ctx context.Context, in *ReqByEvent,
 	return out, nil
 }
 
+func (c *eventServiceV1Client) PatchEvent(ctx context.Context, in *ReqPatchEvent, opts ...grpc.CallOption) (*RepConflicts, error) {
+	out := new(RepConflicts)
+	err := c.cc.Invoke(ctx, EventServiceV1_PatchEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventServiceV1Client) DeleteEvent(ctx context.Context, in *ReqByID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, EventServiceV1_DeleteEvent_FullMethodName, in, out, opts...)
@@ -88,6 +149,33 @@ func (c *eventServiceV1Client) GetEventByID(ctx context.Context, in *ReqByID, op
 	return out, nil
 }
 
+func (c *eventServiceV1Client) GetEventHistory(ctx context.Context, in *ReqByID, opts ...grpc.CallOption) (*RepAuditEntries, error) {
+	out := new(RepAuditEntries)
+	err := c.cc.Invoke(ctx, EventServiceV1_GetEventHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceV1Client) GetTrash(ctx context.Context, in *ReqByUser, opts ...grpc.CallOption) (*RepEvents, error) {
+	out := new(RepEvents)
+	err := c.cc.Invoke(ctx, EventServiceV1_GetTrash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceV1Client) RestoreEvent(ctx context.Context, in *ReqTrashEvent, opts ...grpc.CallOption) (*RepID, error) {
+	out := new(RepID)
+	err := c.cc.Invoke(ctx, EventServiceV1_RestoreEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventServiceV1Client) GetAllEvents(ctx context.Context, in *ReqByUser, opts ...grpc.CallOption) (*RepEvents, error) {
 	out := new(RepEvents)
 	err := c.cc.Invoke(ctx, EventServiceV1_GetAllEvents_FullMethodName, in, out, opts...)
@@ -124,18 +212,242 @@ func (c *eventServiceV1Client) GetAllEventsMonth(ctx context.Context, in *ReqByU
 	return out, nil
 }
 
+func (c *eventServiceV1Client) GetUser(ctx context.Context, in *ReqByUser, opts ...grpc.CallOption) (*RepUser, error) {
+	out := new(RepUser)
+	err := c.cc.Invoke(ctx, EventServiceV1_GetUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceV1Client) UpdateUser(ctx context.Context, in *ReqByUserSettings, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EventServiceV1_UpdateUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceV1Client) ImportEvents(ctx context.Context, in *ReqImport, opts ...grpc.CallOption) (*RepImport, error) {
+	out := new(RepImport)
+	err := c.cc.Invoke(ctx, EventServiceV1_ImportEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceV1Client) ExportEvents(ctx context.Context, in *ReqByUser, opts ...grpc.CallOption) (*RepExport, error) {
+	out := new(RepExport)
+	err := c.cc.Invoke(ctx, EventServiceV1_ExportEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceV1Client) CreateFeedToken(ctx context.Context, in *ReqByUser, opts ...grpc.CallOption) (*RepFeedTokens, error) {
+	out := new(RepFeedTokens)
+	err := c.cc.Invoke(ctx, EventServiceV1_CreateFeedToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceV1Client) GetFeedTokens(ctx context.Context, in *ReqByUser, opts ...grpc.CallOption) (*RepFeedTokens, error) {
+	out := new(RepFeedTokens)
+	err := c.cc.Invoke(ctx, EventServiceV1_GetFeedTokens_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceV1Client) RevokeFeedToken(ctx context.Context, in *ReqFeedToken, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EventServiceV1_RevokeFeedToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceV1Client) CreateCalendar(ctx context.Context, in *ReqByCalendar, opts ...grpc.CallOption) (*RepCalendars, error) {
+	out := new(RepCalendars)
+	err := c.cc.Invoke(ctx, EventServiceV1_CreateCalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceV1Client) UpdateCalendar(ctx context.Context, in *ReqByCalendar, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EventServiceV1_UpdateCalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceV1Client) DeleteCalendar(ctx context.Context, in *ReqByID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EventServiceV1_DeleteCalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceV1Client) GetCalendar(ctx context.Context, in *ReqByID, opts ...grpc.CallOption) (*RepCalendars, error) {
+	out := new(RepCalendars)
+	err := c.cc.Invoke(ctx, EventServiceV1_GetCalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceV1Client) GetCalendars(ctx context.Context, in *ReqByUser, opts ...grpc.CallOption) (*RepCalendars, error) {
+	out := new(RepCalendars)
+	err := c.cc.Invoke(ctx, EventServiceV1_GetCalendars_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceV1Client) ShareCalendar(ctx context.Context, in *ReqByCalendarShare, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EventServiceV1_ShareCalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceV1Client) UnshareCalendar(ctx context.Context, in *ReqByCalendarShare, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EventServiceV1_UnshareCalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceV1Client) GetCalendarShares(ctx context.Context, in *ReqByID, opts ...grpc.CallOption) (*RepCalendarShares, error) {
+	out := new(RepCalendarShares)
+	err := c.cc.Invoke(ctx, EventServiceV1_GetCalendarShares_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceV1Client) GetCalendarsEvents(ctx context.Context, in *ReqByCalendarsByRange, opts ...grpc.CallOption) (*RepEvents, error) {
+	out := new(RepEvents)
+	err := c.cc.Invoke(ctx, EventServiceV1_GetCalendarsEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceV1Client) InviteAttendee(ctx context.Context, in *ReqByAttendee, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EventServiceV1_InviteAttendee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceV1Client) RespondInvitation(ctx context.Context, in *ReqByAttendee, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EventServiceV1_RespondInvitation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceV1Client) RemoveAttendee(ctx context.Context, in *ReqByAttendee, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EventServiceV1_RemoveAttendee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceV1Client) GetInvitations(ctx context.Context, in *ReqByUser, opts ...grpc.CallOption) (*RepInvitations, error) {
+	out := new(RepInvitations)
+	err := c.cc.Invoke(ctx, EventServiceV1_GetInvitations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceV1Client) GetFreeBusy(ctx context.Context, in *ReqFreeBusy, opts ...grpc.CallOption) (*RepFreeBusy, error) {
+	out := new(RepFreeBusy)
+	err := c.cc.Invoke(ctx, EventServiceV1_GetFreeBusy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceV1Client) FindSlots(ctx context.Context, in *ReqSlots, opts ...grpc.CallOption) (*RepIntervals, error) {
+	out := new(RepIntervals)
+	err := c.cc.Invoke(ctx, EventServiceV1_FindSlots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EventServiceV1Server is the server API for EventServiceV1 service.
 // All implementations must embed UnimplementedEventServiceV1Server
 // for forward compatibility
 type EventServiceV1Server interface {
 	InsertEvent(context.Context, *ReqByEvent) (*RepID, error)
-	UpdateEvent(context.Context, *ReqByEvent) (*emptypb.Empty, error)
+	UpdateEvent(context.Context, *ReqByEvent) (*RepConflicts, error)
+	PatchEvent(context.Context, *ReqPatchEvent) (*RepConflicts, error)
 	DeleteEvent(context.Context, *ReqByID) (*emptypb.Empty, error)
 	GetEventByID(context.Context, *ReqByID) (*RepEvents, error)
+	GetEventHistory(context.Context, *ReqByID) (*RepAuditEntries, error)
+	GetTrash(context.Context, *ReqByUser) (*RepEvents, error)
+	RestoreEvent(context.Context, *ReqTrashEvent) (*RepID, error)
 	GetAllEvents(context.Context, *ReqByUser) (*RepEvents, error)
 	GetAllEventsDay(context.Context, *ReqByUserByDate) (*RepEvents, error)
 	GetAllEventsWeek(context.Context, *ReqByUserByDate) (*RepEvents, error)
 	GetAllEventsMonth(context.Context, *ReqByUserByDate) (*RepEvents, error)
+	GetUser(context.Context, *ReqByUser) (*RepUser, error)
+	UpdateUser(context.Context, *ReqByUserSettings) (*emptypb.Empty, error)
+	ImportEvents(context.Context, *ReqImport) (*RepImport, error)
+	ExportEvents(context.Context, *ReqByUser) (*RepExport, error)
+	CreateFeedToken(context.Context, *ReqByUser) (*RepFeedTokens, error)
+	GetFeedTokens(context.Context, *ReqByUser) (*RepFeedTokens, error)
+	RevokeFeedToken(context.Context, *ReqFeedToken) (*emptypb.Empty, error)
+	CreateCalendar(context.Context, *ReqByCalendar) (*RepCalendars, error)
+	UpdateCalendar(context.Context, *ReqByCalendar) (*emptypb.Empty, error)
+	DeleteCalendar(context.Context, *ReqByID) (*emptypb.Empty, error)
+	GetCalendar(context.Context, *ReqByID) (*RepCalendars, error)
+	GetCalendars(context.Context, *ReqByUser) (*RepCalendars, error)
+	ShareCalendar(context.Context, *ReqByCalendarShare) (*emptypb.Empty, error)
+	UnshareCalendar(context.Context, *ReqByCalendarShare) (*emptypb.Empty, error)
+	GetCalendarShares(context.Context, *ReqByID) (*RepCalendarShares, error)
+	GetCalendarsEvents(context.Context, *ReqByCalendarsByRange) (*RepEvents, error)
+	InviteAttendee(context.Context, *ReqByAttendee) (*emptypb.Empty, error)
+	RespondInvitation(context.Context, *ReqByAttendee) (*emptypb.Empty, error)
+	RemoveAttendee(context.Context, *ReqByAttendee) (*emptypb.Empty, error)
+	GetInvitations(context.Context, *ReqByUser) (*RepInvitations, error)
+	GetFreeBusy(context.Context, *ReqFreeBusy) (*RepFreeBusy, error)
+	FindSlots(context.Context, *ReqSlots) (*RepIntervals, error)
 	mustEmbedUnimplementedEventServiceV1Server()
 }
 
@@ -146,15 +458,27 @@ type UnimplementedEventServiceV1Server struct {
 func (UnimplementedEventServiceV1Server) InsertEvent(context.Context, *ReqByEvent) (*RepID, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertEvent not implemented")
 }
-func (UnimplementedEventServiceV1Server) UpdateEvent(context.Context, *ReqByEvent) (*emptypb.Empty, error) {
+func (UnimplementedEventServiceV1Server) UpdateEvent(context.Context, *ReqByEvent) (*RepConflicts, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateEvent not implemented")
 }
+func (UnimplementedEventServiceV1Server) PatchEvent(context.Context, *ReqPatchEvent) (*RepConflicts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchEvent not implemented")
+}
 func (UnimplementedEventServiceV1Server) DeleteEvent(context.Context, *ReqByID) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEvent not implemented")
 }
 func (UnimplementedEventServiceV1Server) GetEventByID(context.Context, *ReqByID) (*RepEvents, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEventByID not implemented")
 }
+func (UnimplementedEventServiceV1Server) GetEventHistory(context.Context, *ReqByID) (*RepAuditEntries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventHistory not implemented")
+}
+func (UnimplementedEventServiceV1Server) GetTrash(context.Context, *ReqByUser) (*RepEvents, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrash not implemented")
+}
+func (UnimplementedEventServiceV1Server) RestoreEvent(context.Context, *ReqTrashEvent) (*RepID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreEvent not implemented")
+}
 func (UnimplementedEventServiceV1Server) GetAllEvents(context.Context, *ReqByUser) (*RepEvents, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllEvents not implemented")
 }
@@ -167,6 +491,72 @@ func (UnimplementedEventServiceV1Server) GetAllEventsWeek(context.Context, *ReqB
 func (UnimplementedEventServiceV1Server) GetAllEventsMonth(context.Context, *ReqByUserByDate) (*RepEvents, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllEventsMonth not implemented")
 }
+func (UnimplementedEventServiceV1Server) GetUser(context.Context, *ReqByUser) (*RepUser, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedEventServiceV1Server) UpdateUser(context.Context, *ReqByUserSettings) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedEventServiceV1Server) ImportEvents(context.Context, *ReqImport) (*RepImport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportEvents not implemented")
+}
+func (UnimplementedEventServiceV1Server) ExportEvents(context.Context, *ReqByUser) (*RepExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportEvents not implemented")
+}
+func (UnimplementedEventServiceV1Server) CreateFeedToken(context.Context, *ReqByUser) (*RepFeedTokens, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFeedToken not implemented")
+}
+func (UnimplementedEventServiceV1Server) GetFeedTokens(context.Context, *ReqByUser) (*RepFeedTokens, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeedTokens not implemented")
+}
+func (UnimplementedEventServiceV1Server) RevokeFeedToken(context.Context, *ReqFeedToken) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeFeedToken not implemented")
+}
+func (UnimplementedEventServiceV1Server) CreateCalendar(context.Context, *ReqByCalendar) (*RepCalendars, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCalendar not implemented")
+}
+func (UnimplementedEventServiceV1Server) UpdateCalendar(context.Context, *ReqByCalendar) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCalendar not implemented")
+}
+func (UnimplementedEventServiceV1Server) DeleteCalendar(context.Context, *ReqByID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCalendar not implemented")
+}
+func (UnimplementedEventServiceV1Server) GetCalendar(context.Context, *ReqByID) (*RepCalendars, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendar not implemented")
+}
+func (UnimplementedEventServiceV1Server) GetCalendars(context.Context, *ReqByUser) (*RepCalendars, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendars not implemented")
+}
+func (UnimplementedEventServiceV1Server) ShareCalendar(context.Context, *ReqByCalendarShare) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareCalendar not implemented")
+}
+func (UnimplementedEventServiceV1Server) UnshareCalendar(context.Context, *ReqByCalendarShare) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshareCalendar not implemented")
+}
+func (UnimplementedEventServiceV1Server) GetCalendarShares(context.Context, *ReqByID) (*RepCalendarShares, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarShares not implemented")
+}
+func (UnimplementedEventServiceV1Server) GetCalendarsEvents(context.Context, *ReqByCalendarsByRange) (*RepEvents, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarsEvents not implemented")
+}
+func (UnimplementedEventServiceV1Server) InviteAttendee(context.Context, *ReqByAttendee) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteAttendee not implemented")
+}
+func (UnimplementedEventServiceV1Server) RespondInvitation(context.Context, *ReqByAttendee) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondInvitation not implemented")
+}
+func (UnimplementedEventServiceV1Server) RemoveAttendee(context.Context, *ReqByAttendee) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAttendee not implemented")
+}
+func (UnimplementedEventServiceV1Server) GetInvitations(context.Context, *ReqByUser) (*RepInvitations, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvitations not implemented")
+}
+func (UnimplementedEventServiceV1Server) GetFreeBusy(context.Context, *ReqFreeBusy) (*RepFreeBusy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFreeBusy not implemented")
+}
+func (UnimplementedEventServiceV1Server) FindSlots(context.Context, *ReqSlots) (*RepIntervals, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindSlots not implemented")
+}
 func (UnimplementedEventServiceV1Server) mustEmbedUnimplementedEventServiceV1Server() {}
 
 // UnsafeEventServiceV1Server may be embedded to opt out of forward compatibility for this service.
@@ -216,6 +606,24 @@ func _EventServiceV1_UpdateEvent_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventServiceV1_PatchEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqPatchEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).PatchEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_PatchEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).PatchEvent(ctx, req.(*ReqPatchEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventServiceV1_DeleteEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReqByID)
 	if err := dec(in); err != nil {
@@ -252,6 +660,60 @@ func _EventServiceV1_GetEventByID_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventServiceV1_GetEventHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqByID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).GetEventHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_GetEventHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).GetEventHistory(ctx, req.(*ReqByID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventServiceV1_GetTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqByUser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).GetTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_GetTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).GetTrash(ctx, req.(*ReqByUser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventServiceV1_RestoreEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqTrashEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).RestoreEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_RestoreEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).RestoreEvent(ctx, req.(*ReqTrashEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventServiceV1_GetAllEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReqByUser)
 	if err := dec(in); err != nil {
@@ -324,13 +786,409 @@ func _EventServiceV1_GetAllEventsMonth_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
-// EventServiceV1_ServiceDesc is the grpc.ServiceDesc for EventServiceV1 service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var EventServiceV1_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "event_service_v1.EventServiceV1",
-	HandlerType: (*EventServiceV1Server)(nil),
-	Methods: []grpc.MethodDesc{
+func _EventServiceV1_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqByUser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).GetUser(ctx, req.(*ReqByUser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventServiceV1_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqByUserSettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).UpdateUser(ctx, req.(*ReqByUserSettings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventServiceV1_ImportEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqImport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).ImportEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_ImportEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).ImportEvents(ctx, req.(*ReqImport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventServiceV1_ExportEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqByUser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).ExportEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_ExportEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).ExportEvents(ctx, req.(*ReqByUser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventServiceV1_CreateFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqByUser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).CreateFeedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_CreateFeedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).CreateFeedToken(ctx, req.(*ReqByUser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventServiceV1_GetFeedTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqByUser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).GetFeedTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_GetFeedTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).GetFeedTokens(ctx, req.(*ReqByUser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventServiceV1_RevokeFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqFeedToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).RevokeFeedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_RevokeFeedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).RevokeFeedToken(ctx, req.(*ReqFeedToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventServiceV1_CreateCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqByCalendar)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).CreateCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_CreateCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).CreateCalendar(ctx, req.(*ReqByCalendar))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventServiceV1_UpdateCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqByCalendar)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).UpdateCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_UpdateCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).UpdateCalendar(ctx, req.(*ReqByCalendar))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventServiceV1_DeleteCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqByID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).DeleteCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_DeleteCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).DeleteCalendar(ctx, req.(*ReqByID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventServiceV1_GetCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqByID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).GetCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_GetCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).GetCalendar(ctx, req.(*ReqByID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventServiceV1_GetCalendars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqByUser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).GetCalendars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_GetCalendars_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).GetCalendars(ctx, req.(*ReqByUser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventServiceV1_ShareCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqByCalendarShare)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).ShareCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_ShareCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).ShareCalendar(ctx, req.(*ReqByCalendarShare))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventServiceV1_UnshareCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqByCalendarShare)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).UnshareCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_UnshareCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).UnshareCalendar(ctx, req.(*ReqByCalendarShare))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventServiceV1_GetCalendarShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqByID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).GetCalendarShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_GetCalendarShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).GetCalendarShares(ctx, req.(*ReqByID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventServiceV1_GetCalendarsEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqByCalendarsByRange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).GetCalendarsEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_GetCalendarsEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).GetCalendarsEvents(ctx, req.(*ReqByCalendarsByRange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventServiceV1_InviteAttendee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqByAttendee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).InviteAttendee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_InviteAttendee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).InviteAttendee(ctx, req.(*ReqByAttendee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventServiceV1_RespondInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqByAttendee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).RespondInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_RespondInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).RespondInvitation(ctx, req.(*ReqByAttendee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventServiceV1_RemoveAttendee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqByAttendee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).RemoveAttendee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_RemoveAttendee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).RemoveAttendee(ctx, req.(*ReqByAttendee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventServiceV1_GetInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqByUser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).GetInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_GetInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).GetInvitations(ctx, req.(*ReqByUser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventServiceV1_GetFreeBusy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqFreeBusy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).GetFreeBusy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_GetFreeBusy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).GetFreeBusy(ctx, req.(*ReqFreeBusy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventServiceV1_FindSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqSlots)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceV1Server).FindSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventServiceV1_FindSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceV1Server).FindSlots(ctx, req.(*ReqSlots))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EventServiceV1_ServiceDesc is the grpc.ServiceDesc for EventServiceV1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EventServiceV1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "event_service_v1.EventServiceV1",
+	HandlerType: (*EventServiceV1Server)(nil),
+	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "InsertEvent",
 			Handler:    _EventServiceV1_InsertEvent_Handler,
@@ -339,6 +1197,10 @@ var EventServiceV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateEvent",
 			Handler:    _EventServiceV1_UpdateEvent_Handler,
 		},
+		{
+			MethodName: "PatchEvent",
+			Handler:    _EventServiceV1_PatchEvent_Handler,
+		},
 		{
 			MethodName: "DeleteEvent",
 			Handler:    _EventServiceV1_DeleteEvent_Handler,
@@ -347,6 +1209,18 @@ var EventServiceV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEventByID",
 			Handler:    _EventServiceV1_GetEventByID_Handler,
 		},
+		{
+			MethodName: "GetEventHistory",
+			Handler:    _EventServiceV1_GetEventHistory_Handler,
+		},
+		{
+			MethodName: "GetTrash",
+			Handler:    _EventServiceV1_GetTrash_Handler,
+		},
+		{
+			MethodName: "RestoreEvent",
+			Handler:    _EventServiceV1_RestoreEvent_Handler,
+		},
 		{
 			MethodName: "GetAllEvents",
 			Handler:    _EventServiceV1_GetAllEvents_Handler,
@@ -363,6 +1237,94 @@ var EventServiceV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllEventsMonth",
 			Handler:    _EventServiceV1_GetAllEventsMonth_Handler,
 		},
+		{
+			MethodName: "GetUser",
+			Handler:    _EventServiceV1_GetUser_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _EventServiceV1_UpdateUser_Handler,
+		},
+		{
+			MethodName: "ImportEvents",
+			Handler:    _EventServiceV1_ImportEvents_Handler,
+		},
+		{
+			MethodName: "ExportEvents",
+			Handler:    _EventServiceV1_ExportEvents_Handler,
+		},
+		{
+			MethodName: "CreateFeedToken",
+			Handler:    _EventServiceV1_CreateFeedToken_Handler,
+		},
+		{
+			MethodName: "GetFeedTokens",
+			Handler:    _EventServiceV1_GetFeedTokens_Handler,
+		},
+		{
+			MethodName: "RevokeFeedToken",
+			Handler:    _EventServiceV1_RevokeFeedToken_Handler,
+		},
+		{
+			MethodName: "CreateCalendar",
+			Handler:    _EventServiceV1_CreateCalendar_Handler,
+		},
+		{
+			MethodName: "UpdateCalendar",
+			Handler:    _EventServiceV1_UpdateCalendar_Handler,
+		},
+		{
+			MethodName: "DeleteCalendar",
+			Handler:    _EventServiceV1_DeleteCalendar_Handler,
+		},
+		{
+			MethodName: "GetCalendar",
+			Handler:    _EventServiceV1_GetCalendar_Handler,
+		},
+		{
+			MethodName: "GetCalendars",
+			Handler:    _EventServiceV1_GetCalendars_Handler,
+		},
+		{
+			MethodName: "ShareCalendar",
+			Handler:    _EventServiceV1_ShareCalendar_Handler,
+		},
+		{
+			MethodName: "UnshareCalendar",
+			Handler:    _EventServiceV1_UnshareCalendar_Handler,
+		},
+		{
+			MethodName: "GetCalendarShares",
+			Handler:    _EventServiceV1_GetCalendarShares_Handler,
+		},
+		{
+			MethodName: "GetCalendarsEvents",
+			Handler:    _EventServiceV1_GetCalendarsEvents_Handler,
+		},
+		{
+			MethodName: "InviteAttendee",
+			Handler:    _EventServiceV1_InviteAttendee_Handler,
+		},
+		{
+			MethodName: "RespondInvitation",
+			Handler:    _EventServiceV1_RespondInvitation_Handler,
+		},
+		{
+			MethodName: "RemoveAttendee",
+			Handler:    _EventServiceV1_RemoveAttendee_Handler,
+		},
+		{
+			MethodName: "GetInvitations",
+			Handler:    _EventServiceV1_GetInvitations_Handler,
+		},
+		{
+			MethodName: "GetFreeBusy",
+			Handler:    _EventServiceV1_GetFreeBusy_Handler,
+		},
+		{
+			MethodName: "FindSlots",
+			Handler:    _EventServiceV1_FindSlots_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "EventService.proto",